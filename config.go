@@ -0,0 +1,230 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/oasgo/oasgo/generator"
+	"github.com/oasgo/oasgo/spec"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// config of the generation of several specs e.g.: oasgo.yaml
+//
+//	type-map:
+//	  uuid: github.com/google/uuid.UUID
+//	specs:
+//	  - file: pets.yaml
+//	    targets:
+//	      - kind: client
+//	        output: pets/client/client.go
+//	        tags: [pets]
+//	      - kind: mocks
+//	        output: pets/client/mocks.go
+//	        tags: [pets]
+//
+// Paths are relative to the directory of the config file.
+// Mocks select the same operations as the client of their package, so they implement its interface.
+type config struct {
+	// TypeMap is shared by all targets, mappings of targets override it.
+	TypeMap map[string]string `yaml:"type-map"`
+	Specs   []specConfig
+}
+
+type specConfig struct {
+	File    string
+	Targets []targetConfig
+}
+
+type targetConfig struct {
+	// Kind is client, dto, server or mocks.
+	Kind    string
+	Package string
+	Output  string
+	// OutputDir is set instead of the Output to split generated code into files.
+	OutputDir    string `yaml:"output-dir"`
+	Abbreviate   bool
+	Validator    string
+	Optional     string
+	LenientEnums bool              `yaml:"lenient-enums"`
+	KeepOrder    bool              `yaml:"keep-order"`
+	TypeMap      map[string]string `yaml:"type-map"`
+	// Tags and Operations select operations by their tags or IDs.
+	Tags       []string
+	Operations []string
+}
+
+// packages are default package names of the kinds. Mocks are generated into the package of the client.
+var packages = map[string]string{
+	"client": "client",
+	"dto":    "dto",
+	"server": "server",
+	"mocks":  "client",
+}
+
+// loadConfig reads the config file and resolves its paths.
+func loadConfig(path string) (*config, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	c := &config{}
+	if err := yaml.UnmarshalStrict(data, c); err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err)
+	}
+
+	dir := filepath.Dir(path)
+	for i := range c.Specs {
+		s := &c.Specs[i]
+		if s.File == "" {
+			return nil, fmt.Errorf("%s: file of the spec %d is not set", path, i)
+		}
+		if !strings.Contains(s.File, "://") && !filepath.IsAbs(s.File) {
+			s.File = filepath.Join(dir, s.File)
+		}
+		for j := range s.Targets {
+			t := &s.Targets[j]
+			pkg, ok := packages[t.Kind]
+			if !ok {
+				return nil, fmt.Errorf("%s: unknown kind %q of the target %d of %s, expected client, dto, server or mocks", path, t.Kind, j, s.File)
+			}
+			if (t.Output == "") == (t.OutputDir == "") {
+				return nil, fmt.Errorf("%s: either output or output-dir of the target %d of %s must be set", path, j, s.File)
+			}
+			if t.Output != "" && !filepath.IsAbs(t.Output) {
+				t.Output = filepath.Join(dir, t.Output)
+			}
+			if t.OutputDir != "" && !filepath.IsAbs(t.OutputDir) {
+				t.OutputDir = filepath.Join(dir, t.OutputDir)
+			}
+			if t.Package == "" {
+				t.Package = pkg
+			}
+			if t.Optional == "" {
+				t.Optional = generator.OptionalValue
+			}
+		}
+		if err := s.checkMocks(); err != nil {
+			return nil, fmt.Errorf("%s: %s", path, err)
+		}
+	}
+	return c, nil
+}
+
+// checkMocks checks that mocks implement the client interface of their package:
+// mocks targets must select the same operations as client targets of the same package.
+func (s *specConfig) checkMocks() error {
+	for i, m := range s.Targets {
+		if m.Kind != "mocks" {
+			continue
+		}
+		for j, t := range s.Targets {
+			if t.Kind != "client" || t.Package != m.Package || t.dir() != m.dir() {
+				continue
+			}
+			if !sameStrings(t.Tags, m.Tags) || !sameStrings(t.Operations, m.Operations) {
+				return fmt.Errorf("tags and operations of the mocks target %d of %s must match the client target %d", i, s.File, j)
+			}
+		}
+	}
+	return nil
+}
+
+// dir returns the directory of the package of the target.
+func (t targetConfig) dir() string {
+	if t.OutputDir != "" {
+		return t.OutputDir
+	}
+	return filepath.Dir(t.Output)
+}
+
+// sameStrings reports whether the lists have the same strings in any order.
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	count := map[string]int{}
+	for _, s := range a {
+		count[s]++
+	}
+	for _, s := range b {
+		if count[s]--; count[s] < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// options returns generation options of the target.
+func (c *config) options(t targetConfig) (generator.Options, error) {
+	types := map[string]string{}
+	for f, typ := range c.TypeMap {
+		types[f] = typ
+	}
+	for f, typ := range t.TypeMap {
+		types[f] = typ
+	}
+	o := generator.Options{
+		PackageName:  t.Package,
+		IsAbbreviate: t.Abbreviate,
+		Validator:    t.Validator,
+		LenientEnums: t.LenientEnums,
+		Optional:     t.Optional,
+		TypeMap:      types,
+		Tags:         t.Tags,
+		Operations:   t.Operations,
+		KeepOrder:    t.KeepOrder,
+	}
+	return o, o.Validate()
+}
+
+// run generates all targets of the config. Failed targets don't stop others,
+// the exit code of the first failure is returned.
+func (c *config) run() int {
+	code := 0
+	fail := func(exit int, err error) {
+		fmt.Fprintln(os.Stderr, err)
+		if code == 0 {
+			code = exit
+		}
+	}
+
+	for _, sc := range c.Specs {
+		s, err := spec.Load(sc.File)
+		if err != nil {
+			fail(exitSpec, err)
+			continue
+		}
+		for _, t := range sc.Targets {
+			dest := t.Output + t.OutputDir
+			o, err := c.options(t)
+			if err != nil {
+				fail(exitUsage, fmt.Errorf("%s: %s", dest, err))
+				continue
+			}
+			if t.OutputDir != "" {
+				files, err := generator.Files(t.Kind, s, o)
+				if err != nil {
+					fail(exitGenerate, fmt.Errorf("%s: %s", dest, err))
+					continue
+				}
+				if exit, err := outputFiles(t.OutputDir, t.Kind, files); err != nil {
+					fail(exit, err)
+				}
+				continue
+			}
+			src, err := generator.Generate(t.Kind, s, o)
+			if err != nil {
+				fail(exitGenerate, fmt.Errorf("%s: %s", dest, err))
+				continue
+			}
+			if exit, err := output(t.Output, src); err != nil {
+				fail(exit, err)
+			}
+		}
+	}
+	return code
+}
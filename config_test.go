@@ -0,0 +1,272 @@
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/oasgo/oasgo/generator"
+	"github.com/stretchr/testify/assert"
+)
+
+// writeConfig writes the config into the directory and returns its path.
+func writeConfig(t *testing.T, dir, data string) string {
+	path := filepath.Join(dir, "oasgo.yaml")
+	if err := ioutil.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadConfigResolvesPaths(t *testing.T) {
+	dir := t.TempDir()
+	abs := filepath.Join(t.TempDir(), "dto.go")
+	path := writeConfig(t, dir, `
+specs:
+  - file: specs/pets.yaml
+    targets:
+      - kind: client
+        output: pets/client.go
+      - kind: mocks
+        output: `+abs+`
+      - kind: server
+        output-dir: pets/server
+  - file: https://example.com/pets.yaml
+    targets:
+      - kind: dto
+        package: pets
+        output: ../dto/dto.go
+        optional: generic
+`)
+
+	c, err := loadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pets, remote := c.Specs[0], c.Specs[1]
+	assert.Equal(t, filepath.Join(dir, "specs", "pets.yaml"), pets.File)
+	assert.Equal(t, "https://example.com/pets.yaml", remote.File)
+
+	tests := []struct {
+		target                           targetConfig
+		output, outputDir, pkg, optional string
+	}{
+		{pets.Targets[0], filepath.Join(dir, "pets", "client.go"), "", "client", generator.OptionalValue},
+		{pets.Targets[1], abs, "", "client", generator.OptionalValue},
+		{pets.Targets[2], "", filepath.Join(dir, "pets", "server"), "server", generator.OptionalValue},
+		{remote.Targets[0], filepath.Join(filepath.Dir(dir), "dto", "dto.go"), "", "pets", generator.OptionalGeneric},
+	}
+	for _, tt := range tests {
+		t.Run(tt.target.Kind, func(t *testing.T) {
+			assert.Equal(t, tt.output, tt.target.Output)
+			assert.Equal(t, tt.outputDir, tt.target.OutputDir)
+			assert.Equal(t, tt.pkg, tt.target.Package)
+			assert.Equal(t, tt.optional, tt.target.Optional)
+		})
+	}
+}
+
+func TestLoadConfigErrors(t *testing.T) {
+	tests := []struct {
+		name, config, err string
+	}{
+		{
+			name:   "unknown key",
+			config: "specs:\n  - file: pets.yaml\n    target: []\n",
+			err:    "field target not found",
+		},
+		{
+			name:   "missing file",
+			config: "specs:\n  - targets:\n      - kind: dto\n        output: dto.go\n",
+			err:    "file of the spec 0 is not set",
+		},
+		{
+			name:   "unknown kind",
+			config: "specs:\n  - file: pets.yaml\n    targets:\n      - kind: docs\n        output: docs.go\n",
+			err:    `unknown kind "docs" of the target 0 of`,
+		},
+		{
+			name:   "missing output",
+			config: "specs:\n  - file: pets.yaml\n    targets:\n      - kind: dto\n",
+			err:    "either output or output-dir of the target 0 of",
+		},
+		{
+			name:   "output and output directory",
+			config: "specs:\n  - file: pets.yaml\n    targets:\n      - kind: dto\n        output: dto.go\n        output-dir: dto\n",
+			err:    "either output or output-dir of the target 0 of",
+		},
+		{
+			name: "mocks selecting other operations than the client",
+			config: "specs:\n  - file: pets.yaml\n    targets:\n      - kind: client\n        output: client/client.go\n        tags: [pets]\n" +
+				"      - kind: mocks\n        output: client/mocks.go\n",
+			err: "tags and operations of the mocks target 1 of",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeConfig(t, t.TempDir(), tt.config)
+			_, err := loadConfig(path)
+			if assert.Error(t, err) {
+				assert.True(t, strings.HasPrefix(err.Error(), path+": "), err.Error())
+				assert.Contains(t, err.Error(), tt.err)
+			}
+		})
+	}
+
+	_, err := loadConfig(filepath.Join(t.TempDir(), "oasgo.yaml"))
+	assert.Error(t, err)
+}
+
+func TestConfigOptions(t *testing.T) {
+	c := &config{TypeMap: map[string]string{
+		"uuid":      "github.com/google/uuid.UUID",
+		"date-time": "time.Time",
+	}}
+
+	tests := []struct {
+		name    string
+		target  targetConfig
+		typeMap map[string]string
+		err     string
+	}{
+		{
+			name:   "global type map",
+			target: targetConfig{Package: "client", Optional: generator.OptionalValue},
+			typeMap: map[string]string{
+				"uuid":      "github.com/google/uuid.UUID",
+				"date-time": "time.Time",
+			},
+		},
+		{
+			name: "target type map overrides global one",
+			target: targetConfig{Package: "client", Optional: generator.OptionalValue, TypeMap: map[string]string{
+				"uuid":    "github.com/gofrs/uuid/v5.UUID",
+				"decimal": "github.com/shopspring/decimal.Decimal",
+			}},
+			typeMap: map[string]string{
+				"uuid":      "github.com/gofrs/uuid/v5.UUID",
+				"date-time": "time.Time",
+				"decimal":   "github.com/shopspring/decimal.Decimal",
+			},
+		},
+		{
+			name:   "invalid validator",
+			target: targetConfig{Package: "dto", Validator: "reflect", Optional: generator.OptionalValue},
+			err:    "unsupported validator reflect",
+		},
+		{
+			name:   "invalid optional strategy",
+			target: targetConfig{Package: "dto", Optional: "maybe"},
+			err:    "unsupported optional strategy maybe",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o, err := c.options(tt.target)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.typeMap, o.TypeMap)
+		})
+	}
+	// Targets don't change the global type map.
+	assert.Equal(t, "github.com/google/uuid.UUID", c.TypeMap["uuid"])
+}
+
+func TestConfigRunSharedOutputDir(t *testing.T) {
+	file, err := filepath.Abs(filepath.Join("testdata", "pets.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	path := writeConfig(t, dir, `
+specs:
+  - file: `+file+`
+    targets:
+      - kind: client
+        output-dir: client
+      - kind: mocks
+        output-dir: client
+`)
+	c, err := loadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 0, c.run())
+	files := dirFiles(t, filepath.Join(dir, "client"))
+	assert.Contains(t, files, "client.go")
+	assert.Contains(t, files, "mocks.go")
+	assert.Contains(t, files, "types.go")
+
+	check = true
+	defer func() { check = false }()
+	assert.Equal(t, 0, c.run())
+	assert.Equal(t, files, dirFiles(t, filepath.Join(dir, "client")))
+}
+
+func TestConfigRunFilters(t *testing.T) {
+	file, err := filepath.Abs(filepath.Join("testdata", "pets.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	path := writeConfig(t, dir, `
+specs:
+  - file: `+file+`
+    targets:
+      - kind: client
+        output: all/client.go
+      - kind: client
+        output: tagged/client.go
+        tags: [pets]
+      - kind: client
+        output: untagged/client.go
+        tags: [stores]
+      - kind: client
+        output: selected/client.go
+        operations: [showPetById, listPets]
+      - kind: mocks
+        output: selected/mocks.go
+        operations: [listPets, showPetById]
+      - kind: server
+        output: server/server.go
+        tags: [stores]
+        operations: [createPet]
+`)
+	c, err := loadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 0, c.run())
+
+	tests := []struct {
+		file     string
+		selected []string
+		skipped  []string
+	}{
+		{"all/client.go", []string{"CreatePet(", "ListPets(", "ShowPetById("}, nil},
+		{"tagged/client.go", []string{"CreatePet(", "ListPets(", "ShowPetById("}, nil},
+		{"untagged/client.go", nil, []string{"CreatePet(", "ListPets(", "ShowPetById("}},
+		{"selected/client.go", []string{"ListPets(", "ShowPetById("}, []string{"CreatePet("}},
+		{"selected/mocks.go", []string{"ListPets(", "ShowPetById("}, []string{"CreatePet("}},
+		{"server/server.go", []string{"CreatePet("}, []string{"ListPets(", "ShowPetById("}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			data, err := ioutil.ReadFile(filepath.Join(dir, tt.file))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, s := range tt.selected {
+				assert.Contains(t, string(data), s)
+			}
+			for _, s := range tt.skipped {
+				assert.NotContains(t, string(data), s)
+			}
+		})
+	}
+}
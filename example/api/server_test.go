@@ -0,0 +1,232 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type notes struct {
+	calls []string
+}
+
+func (n *notes) CreateNote(ctx context.Context, body CreateNoteRequest) (*CreateNoteResponse, error) {
+	n.calls = append(n.calls, "CreateNote "+body.Text)
+	return &CreateNoteResponse{JSON201: &Note{Id: "1", Text: body.Text}}, nil
+}
+
+func (n *notes) DeleteNote(ctx context.Context, id string) (*DeleteNoteResponse, error) {
+	n.calls = append(n.calls, "DeleteNote "+id)
+	return nil, nil
+}
+
+func (n *notes) ImportNotes(ctx context.Context) (*ImportNotesResponse, error) {
+	n.calls = append(n.calls, "ImportNotes")
+	return nil, nil
+}
+
+func (n *notes) ListNotes(ctx context.Context, limit int32, tag string) (*ListNotesResponse, error) {
+	n.calls = append(n.calls, "ListNotes "+tag)
+	return &ListNotesResponse{JSON200: &[]Note{}}, nil
+}
+
+func (n *notes) ListRecentNotes(ctx context.Context) (*ListRecentNotesResponse, error) {
+	n.calls = append(n.calls, "ListRecentNotes")
+	return &ListRecentNotesResponse{JSON200: &[]Note{}}, nil
+}
+
+func (n *notes) ShowNote(ctx context.Context, id string) (*ShowNoteResponse, error) {
+	n.calls = append(n.calls, "ShowNote "+id)
+	if id != "1" {
+		return &ShowNoteResponse{JSON404: &Error{Message: "note is not found"}}, nil
+	}
+	return &ShowNoteResponse{JSON200: &Note{Id: id}}, nil
+}
+
+func (n *notes) UpdateNote(ctx context.Context, id string, body UpdateNoteRequest) (*UpdateNoteResponse, error) {
+	n.calls = append(n.calls, "UpdateNote "+id+" "+body.Text)
+	return &UpdateNoteResponse{JSON200: &Note{Id: id, Text: body.Text}}, nil
+}
+
+func serve(h http.Handler, method, target, contentType, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	if contentType != "" {
+		r.Header.Set("Content-Type", contentType)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w
+}
+
+func TestRouting(t *testing.T) {
+	tests := []struct {
+		method, target string
+		code           int
+		call           string
+	}{
+		{"GET", "/notes?tag=go", http.StatusOK, "ListNotes go"},
+		{"GET", "/notes/?tag=go", http.StatusOK, "ListNotes go"},
+		{"GET", "/notes/recent", http.StatusOK, "ListRecentNotes"},
+		{"GET", "/notes/1", http.StatusOK, "ShowNote 1"},
+		{"GET", "/notes/a%2Fb", http.StatusNotFound, "ShowNote a/b"},
+		{"DELETE", "/notes/1", http.StatusNoContent, "DeleteNote 1"},
+		{"GET", "/notes/1/comments", http.StatusNotFound, ""},
+		{"GET", "/users", http.StatusNotFound, ""},
+		{"PUT", "/notes/1", http.StatusMethodNotAllowed, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.target, func(t *testing.T) {
+			n := &notes{}
+			w := serve(NewHandler(n), tt.method, tt.target, "", "")
+
+			assert.Equal(t, tt.code, w.Code)
+			if tt.call == "" {
+				assert.Empty(t, n.calls)
+			} else {
+				assert.Equal(t, []string{tt.call}, n.calls)
+			}
+		})
+	}
+}
+
+func TestMethodNotAllowed(t *testing.T) {
+	w := serve(NewHandler(&notes{}), "POST", "/notes/1", "", "")
+
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	assert.Equal(t, "DELETE, GET, PATCH", w.Header().Get("Allow"))
+	assert.JSONEq(t, `{"message": "method POST is not allowed"}`, w.Body.String())
+}
+
+func TestResponses(t *testing.T) {
+	h := NewHandler(&notes{})
+
+	w := serve(h, "POST", "/notes", "application/json", `{"text": "hello"}`)
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"id": "1", "text": "hello"}`, w.Body.String())
+
+	w = serve(h, "GET", "/notes/2", "", "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.JSONEq(t, `{"message": "note is not found"}`, w.Body.String())
+
+	w = serve(h, "GET", "/notes", "", "")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestValidator(t *testing.T) {
+	tests := []struct {
+		name, method, target, contentType, body string
+		errors                                  []FieldError
+	}{
+		{
+			name:   "valid query",
+			method: "GET", target: "/notes?tag=go&limit=10",
+		},
+		{
+			name:   "every invalid parameter",
+			method: "GET", target: "/notes?limit=ten",
+			errors: []FieldError{
+				{Field: "limit", Message: `invalid parameter "limit": strconv.ParseInt: parsing "ten": invalid syntax`},
+				{Field: "tag", Message: `missing required parameter "tag"`},
+			},
+		},
+		{
+			name:   "missing body",
+			method: "POST", target: "/notes", contentType: "application/json",
+			errors: []FieldError{{Field: "body", Message: `missing required parameter "body"`}},
+		},
+		{
+			name:   "invalid body",
+			method: "POST", target: "/notes", contentType: "application/json", body: `{"text": 1}`,
+			errors: []FieldError{{Field: "body", Message: `invalid parameter "body": json: cannot unmarshal number into Go struct field CreateNoteRequest.text of type string`}},
+		},
+		{
+			name:   "not allowed content type",
+			method: "POST", target: "/notes", contentType: "text/plain", body: `{"text": "hello"}`,
+			errors: []FieldError{{Field: "Content-Type", Message: `invalid parameter "Content-Type": content type "text/plain" is not allowed`}},
+		},
+		{
+			name:   "content type with parameters",
+			method: "POST", target: "/notes", contentType: "application/json; charset=utf-8", body: `{"text": "hello"}`,
+		},
+		{
+			name:   "every content type of the body",
+			method: "PATCH", target: "/notes/1", contentType: "application/merge-patch+json", body: `{"text": "hello"}`,
+		},
+		{
+			name:   "not JSON body is not decoded",
+			method: "POST", target: "/notes", contentType: "application/x-www-form-urlencoded", body: "text=hello",
+		},
+		{
+			name:   "only not JSON content",
+			method: "POST", target: "/notes/import", contentType: "application/x-www-form-urlencoded", body: "text=hello",
+		},
+		{
+			name:   "missing body of only not JSON content",
+			method: "POST", target: "/notes/import", contentType: "application/x-www-form-urlencoded",
+			errors: []FieldError{{Field: "body", Message: `missing required parameter "body"`}},
+		},
+		{
+			name:   "not allowed content type of only not JSON content",
+			method: "POST", target: "/notes/import", contentType: "application/json", body: `{"text": "hello"}`,
+			errors: []FieldError{{Field: "Content-Type", Message: `invalid parameter "Content-Type": content type "application/json" is not allowed`}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := false
+			v := NewValidator(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				next = true
+			}))
+			w := serve(v, tt.method, tt.target, tt.contentType, tt.body)
+
+			if tt.errors == nil {
+				assert.True(t, next)
+				assert.Equal(t, http.StatusOK, w.Code)
+				return
+			}
+			assert.False(t, next)
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+
+			var res ValidationErrorResponse
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+			assert.Equal(t, "invalid request", res.Message)
+			assert.Equal(t, tt.errors, res.Errors)
+		})
+	}
+}
+
+func TestValidatorKeepsBody(t *testing.T) {
+	n := &notes{}
+	w := serve(NewValidator(NewHandler(n)), "PATCH", "/notes/1", "application/merge-patch+json", `{"text": "hello"}`)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, []string{"UpdateNote 1 hello"}, n.calls)
+}
+
+func TestValidatorPassesNotJSONBody(t *testing.T) {
+	n := &notes{}
+	w := serve(NewValidator(NewHandler(n)), "POST", "/notes", "application/x-www-form-urlencoded", "text=hello")
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Equal(t, []string{"CreateNote "}, n.calls)
+
+	w = serve(NewValidator(NewHandler(n)), "POST", "/notes/import", "application/x-www-form-urlencoded", "text=hello")
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, []string{"CreateNote ", "ImportNotes"}, n.calls)
+}
+
+func TestValidatorSkipsUnknownRoutes(t *testing.T) {
+	next := false
+	v := NewValidator(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next = true
+	}))
+	serve(v, "GET", "/users", "", "")
+
+	assert.True(t, next)
+}
@@ -1,9 +1,15 @@
 package client
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -24,17 +30,17 @@ func TestListPets(t *testing.T) {
 	tag := "Good boy"
 
 	c, _ := NewHTTPSwaggerPetstoreClient(s.URL)
-	p := Pets{
-		Pet{
-			ID:   1,
+	p := []Pet{
+		{
+			Id:   1,
 			Name: "Doge",
 			Tag:  tag,
 		},
 	}
 
-	var res Pets
-	c.ListPets(&res, nil, 1)
-	assert.Equal(t, p, res)
+	res, err := c.ListPets(context.Background(), "", "1")
+	assert.NoError(t, err)
+	assert.Equal(t, &p, res.JSON200)
 }
 
 func TestShowPetById(t *testing.T) {
@@ -52,15 +58,36 @@ func TestShowPetById(t *testing.T) {
 	c, _ := NewHTTPSwaggerPetstoreClient(s.URL)
 	tag := "Good boy"
 	p := Pet{
-		ID:   1,
+		Id:   1,
 		Name: "Doge",
 		Tag:  tag,
 	}
 
-	var res Pet
-	c.ShowPetById(&res, "1")
+	res, err := c.ShowPetById(context.Background(), "1")
+	assert.NoError(t, err)
+	assert.Equal(t, &p, res.JSON200)
+}
+
+func TestShowPetByIdError(t *testing.T) {
+	t.Parallel()
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"code": 500, "message": "internal error"}`))
+	}))
+	defer s.Close()
+
+	c, _ := NewHTTPSwaggerPetstoreClient(s.URL)
 
-	assert.Equal(t, p, res)
+	res, err := c.ShowPetById(context.Background(), "1")
+	assert.Nil(t, res.JSON200)
+	assert.Equal(t, &Error{Code: 500, Message: "internal error"}, res.Default)
+
+	var apiErr *APIError
+	if assert.True(t, errors.As(err, &apiErr)) {
+		assert.Equal(t, http.StatusInternalServerError, apiErr.StatusCode)
+		assert.Equal(t, res.Default, apiErr.Model)
+	}
 }
 
 func TestCreatePet(t *testing.T) {
@@ -71,20 +98,65 @@ func TestCreatePet(t *testing.T) {
 		assert.Equal(t, "/pets", r.URL.EscapedPath())
 
 		resp, _ := ioutil.ReadFile("./testdata/pet.json")
+		w.WriteHeader(http.StatusCreated)
 		w.Write(resp)
 	}))
 	defer s.Close()
 
 	c, _ := NewHTTPSwaggerPetstoreClient(s.URL)
 	tag := "Good boy"
-	p := Pet{
+	p := CreatePetRequest{
 		Name: "Doge",
 		Tag:  tag,
 	}
 
-	var res Pet
-	c.CreatePet(&res, p)
+	res, err := c.CreatePet(context.Background(), p)
+	assert.NoError(t, err)
+	assert.Equal(t, p.Name, res.JSON201.Name)
+	assert.Equal(t, p.Tag, res.JSON201.Tag)
+}
+
+func TestCreatePetUndeclaredSuccess(t *testing.T) {
+	t.Parallel()
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer s.Close()
+
+	c, _ := NewHTTPSwaggerPetstoreClient(s.URL)
+
+	// 202 isn't declared, its empty body isn't decoded as the default error.
+	res, err := c.CreatePet(context.Background(), CreatePetRequest{Name: "Doge"})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, res.HTTPResponse.StatusCode)
+	assert.Nil(t, res.JSON201)
+	assert.Nil(t, res.Default)
+}
+
+func TestConcurrentRequests(t *testing.T) {
+	t.Parallel()
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.EscapedPath(), "/pets/")
+		fmt.Fprintf(w, `{"id": %s, "name": "Doge"}`, id)
+	}))
+	defer s.Close()
+
+	c, _ := NewHTTPSwaggerPetstoreClient(s.URL)
+
+	var wg sync.WaitGroup
+	for i := 1; i <= 50; i++ {
+		wg.Add(1)
+		go func(id int64) {
+			defer wg.Done()
+
+			res, err := c.ShowPetById(context.Background(), strconv.FormatInt(id, 10))
+			assert.NoError(t, err)
+			assert.Equal(t, id, res.JSON200.Id)
+		}(int64(i))
+	}
+	wg.Wait()
 
-	assert.Equal(t, p.Name, res.Name)
-	assert.Equal(t, p.Tag, res.Tag)
+	assert.Equal(t, "", c.URL.Path)
 }
@@ -0,0 +1,33 @@
+package lenient
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalAcceptsUnknownValues(t *testing.T) {
+	t.Parallel()
+
+	var s ItemStatus
+	assert.NoError(t, s.UnmarshalText([]byte("lost")))
+	assert.Equal(t, ItemStatus("lost"), s)
+	assert.False(t, s.IsValid())
+
+	var item Item
+	assert.NoError(t, json.Unmarshal([]byte(`{"status":"lost","level":3}`), &item))
+	assert.Equal(t, Item{Status: "lost", Level: 3}, item)
+	assert.False(t, item.Level.IsValid())
+}
+
+func TestIntegerUnmarshalTextAcceptsUnknownValues(t *testing.T) {
+	t.Parallel()
+
+	var l ItemLevel
+	assert.NoError(t, l.UnmarshalText([]byte("3")))
+	assert.Equal(t, ItemLevel(3), l)
+	assert.False(t, l.IsValid())
+
+	assert.Error(t, l.UnmarshalText([]byte("high")))
+}
@@ -0,0 +1,53 @@
+package strict
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValid(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range AllItemStatusValues() {
+		assert.True(t, s.IsValid(), s)
+	}
+	assert.Equal(t, []ItemStatus{"in-stock", "In Stock", "sold", "", "!"}, AllItemStatusValues())
+	assert.False(t, ItemStatus("lost").IsValid())
+
+	assert.Equal(t, []ItemLevel{-1, 1, 2}, AllItemLevelValues())
+	assert.True(t, ItemLevelMinus1.IsValid())
+	assert.False(t, ItemLevel(0).IsValid())
+}
+
+func TestUnmarshalRejectsUnknownValues(t *testing.T) {
+	t.Parallel()
+
+	var item Item
+	assert.NoError(t, json.Unmarshal([]byte(`{"status":"In Stock","level":-1}`), &item))
+	assert.Equal(t, Item{Status: ItemStatusInStock1, Level: ItemLevelMinus1}, item)
+
+	var s ItemStatus
+	assert.EqualError(t, s.UnmarshalText([]byte("lost")), `ItemStatus: unknown value "lost"`)
+	assert.EqualError(t, json.Unmarshal([]byte(`{"status":"lost"}`), &item), `ItemStatus: unknown value "lost"`)
+	assert.EqualError(t, json.Unmarshal([]byte(`{"level":3}`), &item), "ItemLevel: unknown value 3")
+}
+
+func TestIntegerUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	var l ItemLevel
+	assert.NoError(t, l.UnmarshalText([]byte("-1")))
+	assert.Equal(t, ItemLevelMinus1, l)
+
+	assert.EqualError(t, l.UnmarshalText([]byte("3")), `ItemLevel: unknown value "3"`)
+	assert.EqualError(t, l.UnmarshalText([]byte("high")), `ItemLevel: strconv.ParseInt: parsing "high": invalid syntax`)
+	assert.EqualError(t, l.UnmarshalText([]byte("4294967296")), `ItemLevel: strconv.ParseInt: parsing "4294967296": value out of range`)
+
+	// Enums are decoded from text e.g.: keys of JSON objects.
+	var m map[ItemLevel]string
+	assert.NoError(t, json.Unmarshal([]byte(`{"1":"low","2":"high"}`), &m))
+	assert.Equal(t, map[ItemLevel]string{ItemLevel1: "low", ItemLevel2: "high"}, m)
+	assert.Error(t, json.Unmarshal([]byte(`{"3":"higher"}`), &m))
+}
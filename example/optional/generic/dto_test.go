@@ -0,0 +1,69 @@
+package generic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalOptionalAndNullable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		pet  Pet
+		json string
+	}{
+		{"absent", Pet{Name: "Rex"}, `{"name":"Rex"}`},
+		{"null", Pet{Name: "Rex", Nickname: Null[string](), Owner: Null[string]()}, `{"name":"Rex","nickname":null,"owner":null}`},
+		{
+			"zero",
+			Pet{Age: Some(int32(0)), Vaccinated: Some(false), Nickname: NewNullable(""), Owner: NewNullable("")},
+			`{"age":0,"name":"","nickname":"","owner":"","vaccinated":false}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.pet)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.json, string(data))
+		})
+	}
+}
+
+func TestUnmarshalOptionalAndNullable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		json string
+		pet  Pet
+	}{
+		{"absent", `{"name":"Rex"}`, Pet{Name: "Rex"}},
+		{"null", `{"nickname":null,"owner":null}`, Pet{Nickname: Null[string](), Owner: Null[string]()}},
+		{
+			"zero",
+			`{"age":0,"vaccinated":false,"nickname":"","owner":""}`,
+			Pet{Age: Some(int32(0)), Vaccinated: Some(false), Nickname: NewNullable(""), Owner: NewNullable("")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var p Pet
+			assert.NoError(t, json.Unmarshal([]byte(tt.json), &p))
+			assert.Equal(t, tt.pet, p)
+		})
+	}
+
+	var p Pet
+	assert.NoError(t, json.Unmarshal([]byte(`{"age":3,"nickname":"Rexy"}`), &p))
+	age, ok := p.Age.Get()
+	assert.True(t, ok)
+	assert.Equal(t, int32(3), age)
+	nickname, ok := p.Nickname.Get()
+	assert.True(t, ok)
+	assert.Equal(t, "Rexy", nickname)
+	_, ok = p.Owner.Get()
+	assert.False(t, ok)
+}
@@ -0,0 +1,147 @@
+// Code generated by https://github.com/oasgo/oasgo. DO NOT EDIT.
+// Source: Pets Version: 1.0.0
+
+// Package pointer is a generated OASGO package.
+
+package pointer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+var _ Pets = new(HTTPPetsClient)
+
+type (
+	Pets interface {
+		UpdatePet(ctx context.Context, id string, limit *int32, active *bool, note *string, body UpdatePetRequest) (*UpdatePetResponse, error)
+	}
+
+	HTTPPetsClient struct {
+		URL  *url.URL
+		HTTP *http.Client
+	}
+
+	// UpdatePetResponse is a response of UpdatePet.
+	UpdatePetResponse struct {
+		HTTPResponse *http.Response
+		JSON200      *Pet
+	}
+
+	Pet struct {
+		Age        *int32  `json:"age,omitempty"`
+		Name       string  `json:"name" valid:"required"`
+		Nickname   *string `json:"nickname,omitempty"`
+		Owner      *string `json:"owner"`
+		Vaccinated *bool   `json:"vaccinated,omitempty"`
+	}
+
+	UpdatePetRequest struct {
+		Age        *int32  `json:"age,omitempty"`
+		Name       string  `json:"name" valid:"required"`
+		Nickname   *string `json:"nickname,omitempty"`
+		Owner      *string `json:"owner"`
+		Vaccinated *bool   `json:"vaccinated,omitempty"`
+	}
+)
+
+func NewHTTPPetsClient(host string) (*HTTPPetsClient, error) {
+	u, err := url.Parse(host)
+	if err != nil {
+		return nil, err
+	}
+	return &HTTPPetsClient{
+		URL:  u,
+		HTTP: &http.Client{},
+	}, nil
+}
+func (c *HTTPPetsClient) UpdatePet(ctx context.Context, id string, limit *int32, active *bool, note *string, body UpdatePetRequest) (*UpdatePetResponse, error) {
+	u := *c.URL
+
+	u.Path = strings.NewReplacer(
+		"{id}", id,
+	).Replace("/pets/{id}")
+
+	q := u.Query()
+
+	if limit != nil {
+		q.Set("limit", strconv.FormatInt(int64((*limit)), 10))
+	}
+
+	if active != nil {
+		q.Set("active", strconv.FormatBool((*active)))
+	}
+
+	if note != nil {
+		q.Set("note", (*note))
+	}
+
+	u.RawQuery = q.Encode()
+
+	bs, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	request, err := http.NewRequestWithContext(ctx, "PATCH", u.String(), bytes.NewBuffer(bs))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, data, err := c.sendRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &UpdatePetResponse{HTTPResponse: resp}
+	var model interface{}
+	switch {
+	case resp.StatusCode == 200:
+		var dest Pet
+		if err := json.Unmarshal(data, &dest); err == nil {
+			res.JSON200 = &dest
+			model = res.JSON200
+		} else if resp.StatusCode < http.StatusBadRequest {
+			return res, err
+		}
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return res, &APIError{StatusCode: resp.StatusCode, Body: data, Model: model}
+	}
+	return res, nil
+}
+
+// APIError is returned for responses with non-success status codes.
+type APIError struct {
+	StatusCode int
+	Body       []byte
+	// Model is the decoded error model declared for the status code, if any.
+	Model interface{}
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("unexpected status %d: %s", e.StatusCode, bytes.TrimSpace(e.Body))
+}
+
+// sendRequest sends the request and reads the response body. The body is left readable in the response.
+func (c *HTTPPetsClient) sendRequest(request *http.Request) (*http.Response, []byte, error) {
+	resp, err := c.HTTP.Do(request)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return resp, nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(data))
+
+	return resp, data, nil
+}
@@ -0,0 +1,70 @@
+package pointer
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestPointerFields(t *testing.T) {
+	t.Parallel()
+
+	// Absent optional fields are omitted, zero values are sent and the nil required nullable field is null.
+	data, err := json.Marshal(UpdatePetRequest{Name: "Rex"})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"name":"Rex","owner":null}`, string(data))
+
+	data, err = json.Marshal(UpdatePetRequest{Age: ptr(int32(0)), Vaccinated: ptr(false), Nickname: ptr(""), Owner: ptr("")})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"age":0,"name":"","nickname":"","owner":"","vaccinated":false}`, string(data))
+
+	var p Pet
+	assert.NoError(t, json.Unmarshal([]byte(`{"age":0,"vaccinated":false,"nickname":null}`), &p))
+	assert.Equal(t, Pet{Age: ptr(int32(0)), Vaccinated: ptr(false)}, p)
+}
+
+func TestPointerQueryParams(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		limit  *int32
+		active *bool
+		note   *string
+		query  string
+	}{
+		{name: "absent", query: ""},
+		{name: "zero", limit: ptr(int32(0)), active: ptr(false), note: ptr(""), query: "active=false&limit=0&note="},
+		{name: "set", limit: ptr(int32(10)), active: ptr(true), note: ptr("hi"), query: "active=true&limit=10&note=hi"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var query, body string
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query = r.URL.RawQuery
+				data, _ := ioutil.ReadAll(r.Body)
+				body = string(data)
+				w.Write([]byte(`{"name":"Rex","age":0}`))
+			}))
+			defer s.Close()
+
+			c, err := NewHTTPPetsClient(s.URL)
+			assert.NoError(t, err)
+			res, err := c.UpdatePet(context.Background(), "1", tt.limit, tt.active, tt.note, UpdatePetRequest{Name: "Rex", Age: ptr(int32(0))})
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.query, query)
+			assert.Equal(t, `{"age":0,"name":"Rex","owner":null}`, body)
+			assert.Equal(t, &Pet{Name: "Rex", Age: ptr(int32(0))}, res.JSON200)
+		})
+	}
+}
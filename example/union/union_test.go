@@ -0,0 +1,130 @@
+package union
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPetRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, p := range []Pet{
+		{Value: &Cat{Meow: true}},
+		{Value: Dog{Bark: "woof"}},
+	} {
+		data, err := json.Marshal(p)
+		assert.NoError(t, err)
+
+		var decoded Pet
+		assert.NoError(t, json.Unmarshal(data, &decoded), string(data))
+		switch v := decoded.Value.(type) {
+		case *Cat:
+			assert.Equal(t, "cat", v.PetType)
+			assert.True(t, v.Meow)
+		case *Dog:
+			assert.Equal(t, "dog", v.PetType)
+			assert.Equal(t, "woof", v.Bark)
+		default:
+			t.Fatalf("unexpected variant %T of %s", v, data)
+		}
+	}
+}
+
+func TestPetMarshalWritesDiscriminator(t *testing.T) {
+	t.Parallel()
+
+	data, err := json.Marshal(Pet{Value: &Cat{PetType: "kitty", Meow: true}})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"petType":"cat","meow":true}`, string(data))
+
+	data, err = json.Marshal(Pet{})
+	assert.NoError(t, err)
+	assert.Equal(t, "null", string(data))
+}
+
+func TestPetMarshalKeepsOrder(t *testing.T) {
+	t.Parallel()
+
+	// The discriminator replaces the property in its place or is prepended if the variant has no such property.
+	for _, tt := range []struct {
+		pet  Pet
+		json string
+	}{
+		{Pet{Value: &Cat{PetType: "kitty", Meow: true}}, `{"petType":"cat","meow":true}`},
+		{Pet{Value: Dog{Bark: "woof"}}, `{"petType":"dog","bark":"woof"}`},
+		{Pet{Value: &Bird{Wings: 2}}, `{"petType":"bird","wings":2}`},
+		{Pet{Value: &Bird{}}, `{"petType":"bird"}`},
+		{Pet{Value: (*Cat)(nil)}, `null`},
+	} {
+		data, err := json.Marshal(tt.pet)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.json, string(data))
+	}
+}
+
+func TestPetUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	var p Pet
+	assert.NoError(t, json.Unmarshal([]byte(`{"petType":"kitty","meow":true}`), &p))
+	assert.IsType(t, &Cat{}, p.Value)
+
+	err := json.Unmarshal([]byte(`{"petType":"fish"}`), &p)
+	assert.EqualError(t, err, `Pet: unknown petType "fish"`)
+}
+
+func TestAnyUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	var a Any
+	assert.NoError(t, json.Unmarshal([]byte(`{"x":1}`), &a))
+	assert.Equal(t, &AnyVariant1{X: 1}, a.Value)
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"petType":"cat","meow":true}`), &a))
+	assert.Equal(t, &Cat{PetType: "cat", Meow: true}, a.Value)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"y":1}`), &a))
+
+	data, err := json.Marshal(a)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"petType":"cat","meow":true}`, string(data))
+}
+
+func TestNodeRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		node Node
+		json string
+	}{
+		{Node{}, `{}`},
+		{Node{Name: "a", Pet: &Pet{Value: &Cat{PetType: "cat", Meow: true}}}, `{"name":"a","pet":{"petType":"cat","meow":true}}`},
+		{Node{Any: &Any{Value: &AnyVariant1{X: 1}}}, `{"any":{"x":1}}`},
+	} {
+		data, err := json.Marshal(tt.node)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.json, string(data))
+
+		var decoded Node
+		assert.NoError(t, json.Unmarshal(data, &decoded), string(data))
+		assert.Equal(t, tt.node, decoded)
+	}
+}
+
+func TestUnmarshalNull(t *testing.T) {
+	t.Parallel()
+
+	var n Node
+	assert.NoError(t, json.Unmarshal([]byte(`{"pet":null,"any":null}`), &n))
+	assert.Equal(t, Node{}, n)
+
+	p := Pet{Value: &Cat{}}
+	assert.NoError(t, json.Unmarshal([]byte(`null`), &p))
+	assert.Nil(t, p.Value)
+
+	a := Any{Value: &Cat{}}
+	assert.NoError(t, json.Unmarshal([]byte(`null`), &a))
+	assert.Nil(t, a.Value)
+}
@@ -0,0 +1,141 @@
+package native
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func validItem() Item {
+	return Item{Name: "doge", Price: 10}
+}
+
+func TestValidItem(t *testing.T) {
+	item := validItem()
+	item.Email = "doge@example.com"
+	item.Tags = []string{"tag", "toy"}
+	item.Attrs = map[string]string{"color": "gold"}
+	item.Children = []Child{{N: 1}}
+
+	ok, err := item.Validate()
+	assert.True(t, ok)
+	assert.NoError(t, err)
+}
+
+func TestAbsentOptionalObject(t *testing.T) {
+	// Required properties of the absent owner aren't checked.
+	item := validItem()
+	ok, err := item.Validate()
+	assert.True(t, ok)
+	assert.NoError(t, err)
+
+	item.Owner = Owner{Email: "doge@example.com"}
+	ok, err = item.Validate()
+	assert.False(t, ok)
+	assert.Equal(t, ValidationErrors{{Field: "item.owner.name", Message: "is required"}}, err)
+}
+
+func TestValidationMessages(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Item)
+		errors ValidationErrors
+	}{
+		{
+			name:   "required",
+			modify: func(i *Item) { i.Name = "" },
+			errors: ValidationErrors{{Field: "item.name", Message: "is required"}},
+		},
+		{
+			name:   "string",
+			modify: func(i *Item) { i.Name = "Dogecoin"; i.Email = "doge" },
+			errors: ValidationErrors{
+				{Field: "item.email", Message: "must be a valid email"},
+				{Field: "item.name", Message: "length must be at most 5"},
+				{Field: "item.name", Message: "must match the pattern ^[a-z\"]+$"},
+			},
+		},
+		{
+			name:   "number",
+			modify: func(i *Item) { i.Price = 0.7; i.Count = 11 },
+			errors: ValidationErrors{
+				{Field: "item.count", Message: "must be <= 10"},
+				{Field: "item.price", Message: "must be a multiple of 0.5"},
+			},
+		},
+		{
+			name:   "exclusive minimum",
+			modify: func(i *Item) { i.Price = -1 },
+			errors: ValidationErrors{{Field: "item.price", Message: "must be > 0"}},
+		},
+		{
+			name:   "empty optional collections",
+			modify: func(i *Item) { i.Tags = []string{}; i.Attrs = map[string]string{} },
+			errors: ValidationErrors{
+				{Field: "item.attrs", Message: "must have at least 1 properties"},
+				{Field: "item.tags", Message: "must have at least 1 items"},
+			},
+		},
+		{
+			name:   "items",
+			modify: func(i *Item) { i.Tags = []string{"tag", "tag", "x", "tail"} },
+			errors: ValidationErrors{
+				{Field: "item.tags", Message: "must have at most 3 items"},
+				{Field: "item.tags", Message: "items must be unique"},
+				{Field: "item.tags[2]", Message: "must match the pattern ^t"},
+				{Field: "item.tags[3]", Message: "length must be at most 3"},
+			},
+		},
+		{
+			name:   "nested items",
+			modify: func(i *Item) { i.Matrix = [][]int64{{1}, {2, 10}}; i.Attrs = map[string]string{"color": ""} },
+			errors: ValidationErrors{
+				{Field: "item.attrs[color]", Message: "length must be at least 1"},
+				{Field: "item.matrix[1][1]", Message: "must be <= 9"},
+			},
+		},
+		{
+			name: "nested structs",
+			modify: func(i *Item) {
+				i.Child = Child{N: 4}
+				i.Children = []Child{{N: 1}, {N: 5}}
+				i.Parent = &Item{Price: 1}
+			},
+			errors: ValidationErrors{
+				{Field: "item.child.n", Message: "must be <= 3"},
+				{Field: "item.children[1].n", Message: "must be <= 3"},
+				{Field: "item.parent.name", Message: "is required"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := validItem()
+			tt.modify(&item)
+
+			ok, err := item.Validate()
+			assert.False(t, ok)
+			assert.Equal(t, tt.errors, err)
+		})
+	}
+}
+
+func TestValidationErrorsMessage(t *testing.T) {
+	item := Item{Price: 200}
+
+	_, err := item.Validate()
+	assert.EqualError(t, err, "item.name: is required; item.price: must be <= 100")
+}
+
+func BenchmarkValidate(b *testing.B) {
+	item := validItem()
+	item.Email = "doge@example.com"
+	item.Tags = []string{"tag", "toy"}
+	item.Children = []Child{{N: 1}, {N: 2}}
+	item.Matrix = [][]int64{{1, 2}, {3}}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		item.Validate()
+	}
+}
@@ -0,0 +1,92 @@
+package tags
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func validItem() Item {
+	return Item{Name: "doge", Price: 10}
+}
+
+func TestValidItem(t *testing.T) {
+	item := validItem()
+	item.Email = "doge@example.com"
+	item.Tags = []string{"tag", "toy"}
+	item.Children = []Child{{N: 1}}
+
+	ok, err := item.Validate()
+	assert.True(t, ok)
+	assert.NoError(t, err)
+}
+
+func TestAbsentOptionalObject(t *testing.T) {
+	// Required properties of the absent owner aren't checked.
+	item := validItem()
+	ok, err := item.Validate()
+	assert.True(t, ok)
+	assert.NoError(t, err)
+
+	item.Owner = Owner{Email: "doge@example.com"}
+	ok, err = item.Validate()
+	assert.False(t, ok)
+	assert.Error(t, err)
+}
+
+func TestValidationMessages(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Item)
+		err    string
+	}{
+		{
+			name:   "pattern",
+			modify: func(i *Item) { i.Name = "Doge" },
+			err:    "name: must match the pattern ^[a-z\"]+$",
+		},
+		{
+			name:   "format",
+			modify: func(i *Item) { i.Email = "doge" },
+			err:    "email: must be a valid email",
+		},
+		{
+			name:   "empty optional slice",
+			modify: func(i *Item) { i.Tags = []string{} },
+			err:    "tags: must have at least 1 items",
+		},
+		{
+			name:   "items",
+			modify: func(i *Item) { i.Tags = []string{"tag", "x"} },
+			err:    "tags[1]: must match the pattern ^t",
+		},
+		{
+			name:   "nested structs",
+			modify: func(i *Item) { i.Children = []Child{{N: 1}, {N: 5}} },
+			err:    "children[1]: n: must be <= 3",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := validItem()
+			tt.modify(&item)
+
+			ok, err := item.Validate()
+			assert.False(t, ok)
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func BenchmarkValidate(b *testing.B) {
+	item := validItem()
+	item.Email = "doge@example.com"
+	item.Tags = []string{"tag", "toy"}
+	item.Children = []Child{{N: 1}, {N: 2}}
+	item.Matrix = [][]int64{{1, 2}, {3}}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		item.Validate()
+	}
+}
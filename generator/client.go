@@ -0,0 +1,113 @@
+package generator
+
+import (
+	"text/template"
+
+	"github.com/oasgo/oasgo/spec"
+)
+
+const ClientTemplate = `
+// Code generated by https://github.com/oasgo/oasgo. DO NOT EDIT.
+// Source: {{ .Info.Title }} Version: {{ .Info.Version }}
+
+// Package {{.PackageName}} is a generated OASGO package.
+
+package {{.PackageName}}
+
+{{ $cName :=  (printf "HTTP%sClient" (goName .Info.Title false) ) }}
+{{ $iName := (printf (goName .Info.Title false)) }}
+
+var _ {{ $iName }} = new({{ $cName }})
+
+type (
+    {{ $iName }} interface {
+		{{- range $f := $.SortedFunctions }}
+			{{$f.RenderSignature}}
+		{{- end -}}
+    }
+
+    {{ $cName }} struct {
+        URL *url.URL
+        HTTP *http.Client
+    }
+	{{ range $f := $.SortedFunctions }}
+		{{- $f.RenderResponse }}
+	{{- end }}
+
+	{{ range $r := $.SortedReferences }}
+		{{$r.Reference.RenderDefinition $.IsAbbreviate}}
+	{{ end }}
+)
+{{ range $r := $.SortedReferences }}
+	{{- $r.Reference.RenderMethods $.IsAbbreviate }}
+{{- end }}
+
+func New{{ $cName }} (host string) (*{{ $cName }}, error) {
+	u, err := url.Parse(host)
+	if err != nil {
+		return nil, err
+	}
+	return &{{$cName}}{
+		URL: u,
+    HTTP: &http.Client{},
+	}, nil
+}
+
+{{- range $f := $.SortedFunctions }}
+func (c *{{ $cName }}) {{$f.RenderSignature}} {
+	{{- $f.RenderBody -}}
+}
+{{ end }}
+
+{{- $.RenderOptionalTypes }}
+{{- $.RenderDiscriminatorHelper }}
+
+// APIError is returned for responses with non-success status codes.
+type APIError struct {
+	StatusCode int
+	Body       []byte
+	// Model is the decoded error model declared for the status code, if any.
+	Model interface{}
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("unexpected status %d: %s", e.StatusCode, bytes.TrimSpace(e.Body))
+}
+
+// sendRequest sends the request and reads the response body. The body is left readable in the response.
+func (c *{{ $cName }}) sendRequest(request *http.Request) (*http.Response, []byte, error) {
+	resp, err := c.HTTP.Do(request)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return resp, nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(data))
+
+	return resp, data, nil
+}
+`
+
+// Client generates the HTTP client of the specification.
+func Client(s *spec.Swagger, o Options) ([]byte, error) {
+	return generate("client", ClientTemplate, s, o)
+}
+
+func getFuncMap() template.FuncMap {
+	return template.FuncMap{
+		"inc": func(i int) int {
+			return i + 1
+		},
+		"goName": func(name string, upper bool) string {
+			return ToCamelCase(true, name)
+		},
+		// pathName returns name of the type in paths of validation errors e.g.: "pet" for "Pet".
+		"pathName": func(name string) string {
+			return ToCamelCase(false, name)
+		},
+	}
+}
@@ -0,0 +1,55 @@
+package generator
+
+import "github.com/oasgo/oasgo/spec"
+
+const (
+	DTOTemplate = `
+// Code generated by https://github.com/oasgo/oasgo. DO NOT EDIT.
+// Source: {{ .Info.Title }} Version: {{ .Info.Version }}
+
+// Package {{.PackageName}} is a generated OASGO package.
+
+package {{.PackageName}}
+type (
+	{{ range $r := $.SortedReferences }}
+		{{$r.Reference.RenderDefinition $.IsAbbreviate}}
+	{{ end }}
+)
+{{ $.RenderPatterns }}
+{{ range $r := $.SortedReferences }}
+	{{- $r.Reference.RenderMethods $.IsAbbreviate }}
+{{- end }}
+{{ range $r := $.SortedReferences }}
+	{{- $name := $r.Reference.RenderName $.IsAbbreviate }}
+	{{- if eq $.Validator "native" }}
+	// Validate returns ValidationErrors with all violations of the {{ $name }}.
+	func (r *{{ $name }}) Validate() (bool, error) {
+		errs := ValidationErrors{}
+		r.validate({{ printf "%q" (pathName $r.Reference.RenderLiteral) }}, &errs)
+		if len(errs) > 0 {
+			return false, errs
+		}
+		return true, nil
+	}
+
+	func (r *{{ $name }}) validate(path string, errs *ValidationErrors) {
+		{{ $r.Reference.RenderNativeValidate }}
+	}
+	{{- else }}
+	func (r *{{ $name }}) Validate() (bool, error) {
+		{{ $r.Reference.RenderValidate "" }}
+	}
+	{{- end }}
+{{ end }}
+{{- if eq $.Validator "native" }}
+{{ $.RenderValidationErrors }}
+{{- end }}
+{{- $.RenderOptionalTypes }}
+{{- $.RenderDiscriminatorHelper }}
+`
+)
+
+// DTO generates types of the specification components with their validation.
+func DTO(s *spec.Swagger, o Options) ([]byte, error) {
+	return generate("dto", DTOTemplate, s, o)
+}
@@ -0,0 +1,139 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"sort"
+	"strings"
+
+	"github.com/oasgo/oasgo/spec"
+)
+
+// knownImports maps package names used by the templates to their import paths.
+var knownImports = map[string]string{
+	"base64":      "encoding/base64",
+	"bytes":       "bytes",
+	"context":     "context",
+	"errors":      "errors",
+	"fmt":         "fmt",
+	"govalidator": "github.com/asaskevich/govalidator",
+	"http":        "net/http",
+	"io":          "io",
+	"ioutil":      "io/ioutil",
+	"json":        "encoding/json",
+	"mail":        "net/mail",
+	"math":        "math",
+	"mime":        "mime",
+	"net":         "net",
+	"reflect":     "reflect",
+	"regexp":      "regexp",
+	"sort":        "sort",
+	"strconv":     "strconv",
+	"strings":     "strings",
+	"time":        "time",
+	"url":         "net/url",
+	"utf8":        "unicode/utf8",
+}
+
+// formatSource adds imports of the packages used by the generated source and formats it.
+// Imports of mapped Go types are given by paths to package names.
+func formatSource(src []byte, imports map[string]string) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, sourceError(src, err)
+	}
+
+	paths := map[string]string{}
+	for _, p := range spec.SortedKeys(imports) {
+		name := imports[p]
+		if name == "" {
+			name = importName(p)
+		}
+		paths[name] = p
+	}
+	for name, p := range knownImports {
+		if _, ok := paths[name]; !ok {
+			paths[name] = p
+		}
+	}
+
+	// Packages are referred by identifiers which are not declared in the file.
+	used := map[string]string{}
+	ast.Inspect(f, func(n ast.Node) bool {
+		if s, ok := n.(*ast.SelectorExpr); ok {
+			if x, ok := s.X.(*ast.Ident); ok && x.Obj == nil && paths[x.Name] != "" {
+				used[paths[x.Name]] = x.Name
+			}
+		}
+		return true
+	})
+
+	if len(used) > 0 {
+		offset := fset.Position(f.Name.End()).Offset
+		buf := bytes.NewBuffer(nil)
+		buf.Write(src[:offset])
+		buf.WriteString("\n\n" + renderImports(used))
+		buf.Write(src[offset:])
+		src = buf.Bytes()
+	}
+
+	out, err := format.Source(src)
+	if err != nil {
+		return nil, sourceError(src, err)
+	}
+	return out, nil
+}
+
+// renderImports renders the import declaration of the paths to package names.
+func renderImports(imports map[string]string) string {
+	paths := []string{}
+	for p := range imports {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	b := strings.Builder{}
+	b.WriteString("import (\n")
+	for _, p := range paths {
+		if name := imports[p]; name != importName(p) {
+			fmt.Fprintf(&b, "\t%s %q\n", name, p)
+			continue
+		}
+		fmt.Fprintf(&b, "\t%q\n", p)
+	}
+	b.WriteString(")\n")
+	return b.String()
+}
+
+// sourceError adds lines of the source around the first syntax error to the error.
+func sourceError(src []byte, err error) error {
+	list, ok := err.(scanner.ErrorList)
+	if !ok || len(list) == 0 {
+		return err
+	}
+	line := list[0].Pos.Line
+	lines := strings.Split(string(src), "\n")
+
+	from, to := line-3, line+3
+	if from < 1 {
+		from = 1
+	}
+	if to > len(lines) {
+		to = len(lines)
+	}
+	b := strings.Builder{}
+	for i := from; i <= to; i++ {
+		marker := " "
+		if i == line {
+			marker = ">"
+		}
+		fmt.Fprintf(&b, "%s %4d | %s\n", marker, i, lines[i-1])
+	}
+	return fmt.Errorf("generated code does not parse: %s\n%s", list[0], b.String())
+}
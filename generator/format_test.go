@@ -0,0 +1,101 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatSource(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		imports map[string]string
+		out     string
+	}{
+		{
+			name: "known imports",
+			src:  "package p\nfunc f(s string) string { return fmt.Sprint(strings.ToUpper(s)) }\n",
+			out: "package p\n\nimport (\n\t\"fmt\"\n\t\"strings\"\n)\n\n" +
+				"func f(s string) string { return fmt.Sprint(strings.ToUpper(s)) }\n",
+		},
+		{
+			name:    "unused imports",
+			src:     "package p\nfunc f(url *U) string { return url.Path }\n",
+			imports: map[string]string{"github.com/google/uuid": "uuid"},
+			out:     "package p\n\nfunc f(url *U) string { return url.Path }\n",
+		},
+		{
+			name: "mapped types",
+			src:  "package p\ntype T struct {\nID uuid.UUID\nAt pgtype.Timestamptz\nMeta yamlv2.MapSlice\n}\n",
+			imports: map[string]string{
+				"github.com/gofrs/uuid/v5":       "uuid",
+				"github.com/jackc/pgx/v5/pgtype": "",
+				"gopkg.in/yaml.v2":               "yamlv2",
+			},
+			out: "package p\n\nimport (\n\t\"github.com/gofrs/uuid/v5\"\n\t\"github.com/jackc/pgx/v5/pgtype\"\n\tyamlv2 \"gopkg.in/yaml.v2\"\n)\n\n" +
+				"type T struct {\n\tID   uuid.UUID\n\tAt   pgtype.Timestamptz\n\tMeta yamlv2.MapSlice\n}\n",
+		},
+		{
+			name:    "aliased major version",
+			src:     "package p\nvar id gofrs.UUID\n",
+			imports: map[string]string{"github.com/gofrs/uuid/v5": "gofrs"},
+			out:     "package p\n\nimport (\n\tgofrs \"github.com/gofrs/uuid/v5\"\n)\n\nvar id gofrs.UUID\n",
+		},
+		{
+			name:    "mapped imports override known ones",
+			src:     "package p\nvar d json.Decimal\n",
+			imports: map[string]string{"example.com/json": "json"},
+			out:     "package p\n\nimport (\n\t\"example.com/json\"\n)\n\nvar d json.Decimal\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := formatSource([]byte(tt.src), tt.imports)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.out, string(out))
+		})
+	}
+}
+
+func TestSourceError(t *testing.T) {
+	lines := []string{"package p", "", "func a() {}", "", "func b() {", "\treturn 1 +", "}", "", "func c() {}", "", "func d() {}"}
+	tests := []struct {
+		name    string
+		src     string
+		line    string
+		snippet []string
+		skipped []string
+	}{
+		{
+			name:    "failing line in the middle",
+			src:     strings.Join(lines, "\n"),
+			line:    "generated code does not parse: 7:1:",
+			snippet: []string{"     4 | \n", "     6 | \treturn 1 +\n", ">    7 | }\n", "    10 | \n"},
+			skipped: []string{"   3 |", "  11 |"},
+		},
+		{
+			name:    "failing first line",
+			src:     "packge p\n\nfunc a() {}\n\nfunc b() {}\n",
+			line:    "generated code does not parse: 1:1:",
+			snippet: []string{">    1 | packge p\n", "     4 | \n"},
+			skipped: []string{"   0 |", "   5 |"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := formatSource([]byte(tt.src), nil)
+			if !assert.Error(t, err) {
+				return
+			}
+			assert.True(t, strings.HasPrefix(err.Error(), tt.line), err.Error())
+			for _, s := range tt.snippet {
+				assert.Contains(t, err.Error(), s)
+			}
+			for _, s := range tt.skipped {
+				assert.NotContains(t, err.Error(), s)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Package generator generates Go code of clients, servers and DTOs by OpenAPI specifications.
+//
+// The specification is converted to the intermediate model Context which is rendered by templates:
+//
+//	s, err := spec.Load("pets.yaml")
+//	...
+//	src, err := generator.Client(s, generator.Options{PackageName: "client"})
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+
+	"github.com/oasgo/oasgo/spec"
+)
+
+// templates of the kinds of generated code.
+var templates = map[string]string{
+	"client": ClientTemplate,
+	"dto":    DTOTemplate,
+	"server": ServerTemplate,
+	"mocks":  MocksTemplate,
+}
+
+// Generate generates the kind of code: client, dto, server or mocks.
+func Generate(kind string, s *spec.Swagger, o Options) ([]byte, error) {
+	text, ok := templates[kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown kind %s", kind)
+	}
+	return generate(kind, text, s, o)
+}
+
+// Files generates the kind of code split into files, see split.
+func Files(kind string, s *spec.Swagger, o Options) (map[string][]byte, error) {
+	text, ok := templates[kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown kind %s", kind)
+	}
+	c, src, err := render(kind, text, s, o)
+	if err != nil {
+		return nil, err
+	}
+	return split(kind, src, c)
+}
+
+// generate renders the template by the Context of the specification and formats the result.
+func generate(name, text string, s *spec.Swagger, o Options) ([]byte, error) {
+	_, src, err := render(name, text, s, o)
+	return src, err
+}
+
+// render renders and formats the template, the Context is returned to inspect the result.
+func render(name, text string, s *spec.Swagger, o Options) (Context, []byte, error) {
+	tmpl, err := template.New(name).Funcs(getFuncMap()).Parse(text)
+	if err != nil {
+		return Context{}, nil, fmt.Errorf("parse %s template: %s", name, err)
+	}
+
+	c, err := NewContext(s, o)
+	if err != nil {
+		return Context{}, nil, err
+	}
+
+	buf := bytes.NewBuffer(nil)
+	if err := tmpl.Execute(buf, c); err != nil {
+		return Context{}, nil, fmt.Errorf("execute %s template: %s", name, err)
+	}
+	src, err := formatSource(buf.Bytes(), c.imports)
+	return c, src, err
+}
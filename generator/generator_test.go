@@ -0,0 +1,543 @@
+package generator
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+
+	"github.com/oasgo/oasgo/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+// renders is a number of renderings compared with the first one, maps are iterated in random order every time.
+const renders = 20
+
+func TestGenerateIsDeterministic(t *testing.T) {
+	for _, path := range []string{"../testdata/pets.yaml", "testdata/maps.yaml"} {
+		for kind := range templates {
+			for _, o := range []Options{
+				{PackageName: "generated"},
+				{PackageName: "generated", Validator: "native", Optional: OptionalGeneric},
+				{PackageName: "generated", IsAbbreviate: true, Optional: OptionalPointer},
+				{PackageName: "generated", KeepOrder: true},
+			} {
+				first := generateFile(t, path, kind, o)
+				for i := 1; i < renders; i++ {
+					if !assert.Equal(t, first, generateFile(t, path, kind, o), "%s %s %+v", path, kind, o) {
+						break
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestFilesAreDeterministic(t *testing.T) {
+	for kind := range templates {
+		first := generateFiles(t, "testdata/maps.yaml", kind)
+		for i := 1; i < renders; i++ {
+			if !assert.Equal(t, first, generateFiles(t, "testdata/maps.yaml", kind), kind) {
+				break
+			}
+		}
+	}
+}
+
+func TestKeepOrder(t *testing.T) {
+	src := generateFile(t, "testdata/maps.yaml", "client", Options{PackageName: "generated", KeepOrder: true})
+	assertOrder(t, src, "GetItem(", "PutItem(", "ListItems(")
+	assertOrder(t, src, "Id ", "Created ", "Name ", "Kind ", "Size ", "Labels ", "Shape ")
+	assertOrder(t, src, "Width ", "Height ", "Depth ")
+
+	src = generateFile(t, "testdata/maps.yaml", "client", Options{PackageName: "generated"})
+	assertOrder(t, src, "GetItem(", "ListItems(", "PutItem(")
+	assertOrder(t, src, "Depth ", "Height ", "Width ")
+}
+
+func TestAllOfMergesProperties(t *testing.T) {
+	src := generateFile(t, "../testdata/pets.yaml", "dto", Options{PackageName: "dto"})
+	dog := src[strings.Index(src, "Dog struct {"):]
+	dog = dog[:strings.Index(dog, "}")]
+	for _, field := range []string{
+		"Breed  string    `json:\"breed\" valid:\"required\"`",
+		"Id     int64     `json:\"id\" valid:\"required\"`",
+		"Name   string    `json:\"name\" valid:\"required\"`",
+		"Nested DogNested `json:\"nested,omitempty\" valid:\"-\"`",
+		"Tag    string    `json:\"tag,omitempty\"`",
+	} {
+		assert.Contains(t, dog, field)
+	}
+}
+
+// assertOrder asserts that the first occurrences of the substrings follow each other in the source.
+func assertOrder(t *testing.T, src string, substrings ...string) {
+	prev := -1
+	for _, s := range substrings {
+		i := strings.Index(src, s)
+		if !assert.True(t, i > prev, "%q is not after %q", s, substrings) {
+			return
+		}
+		prev = i
+	}
+}
+
+func generateFile(t *testing.T, path, kind string, o Options) string {
+	s, err := spec.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	src, err := Generate(kind, s, o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(src)
+}
+
+func generateFiles(t *testing.T, path, kind string) map[string]string {
+	s, err := spec.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fs, err := Files(kind, s, Options{PackageName: "generated"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := map[string]string{}
+	for name, src := range fs {
+		out[name] = string(src)
+	}
+	return out
+}
+
+func TestUnsupportedPattern(t *testing.T) {
+	s, err := spec.Parse([]byte(`
+components:
+  schemas:
+    Password:
+      properties:
+        value:
+          type: string
+          pattern: "^(?=.*[0-9]).{8,}$"
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = Generate("dto", s, Options{PackageName: "dto", Validator: "native"})
+	ds, ok := err.(spec.Diagnostics)
+	if !ok || len(ds) != 1 {
+		t.Fatalf("expected one diagnostic, got %v", err)
+	}
+	assert.Equal(t, "#/components/schemas/Password/properties/value", ds[0].Location.Pointer)
+	assert.Contains(t, ds[0].Message, "pattern ^(?=.*[0-9]).{8,}$ is not supported by Go regular expressions")
+}
+
+func TestUnionWithUnsupportedVariant(t *testing.T) {
+	s, err := spec.Parse([]byte(`
+components:
+  schemas:
+    Pet:
+      oneOf:
+        - type: string
+        - $ref: "#/components/schemas/Cat"
+      discriminator:
+        propertyName: kind
+    Cat:
+      properties:
+        kind:
+          type: string
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = Generate("dto", s, Options{PackageName: "dto"})
+	ds, ok := err.(spec.Diagnostics)
+	if !ok || len(ds) != 1 {
+		t.Fatalf("expected one diagnostic, got %v", err)
+	}
+	assert.Equal(t, "#/components/schemas/Pet/oneOf/0", ds[0].Location.Pointer)
+	assert.Contains(t, ds[0].Message, `unsupported type "string" of Pet variant`)
+}
+
+func TestArrayWithoutItems(t *testing.T) {
+	s, err := spec.Parse([]byte(`
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      parameters:
+        - name: ids
+          in: query
+          schema:
+            type: array
+      responses:
+        '200':
+          description: pets
+          content:
+            application/json:
+              schema:
+                type: array
+components:
+  schemas:
+    Pet:
+      properties:
+        tags:
+          type: array
+        matrix:
+          type: array
+          items:
+            type: array
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for kind := range templates {
+		_, err = Generate(kind, s, Options{PackageName: "generated"})
+		ds, ok := err.(spec.Diagnostics)
+		if !ok {
+			t.Fatalf("%s: expected diagnostics, got %v", kind, err)
+		}
+		pointers := []string{}
+		for _, d := range ds {
+			assert.Equal(t, "items of the array are not set", d.Message)
+			pointers = append(pointers, d.Location.Pointer)
+		}
+		assert.Subset(t, pointers, []string{
+			"#/components/schemas/Pet/properties/tags",
+			"#/components/schemas/Pet/properties/matrix/items",
+			"#/paths/~1pets/get/parameters/0/schema",
+			"#/paths/~1pets/get/responses/200/content/application~1json/schema",
+		}, kind)
+	}
+}
+
+// fset and imports are shared by type checks, so imported packages are type checked once.
+var (
+	fset    = token.NewFileSet()
+	imports = importer.ForCompiler(fset, "source", nil)
+)
+
+// typeCheck fails the test if the generated files of the package don't compile.
+func typeCheck(t *testing.T, files ...string) {
+	t.Helper()
+	fs := []*ast.File{}
+	for _, src := range files {
+		f, err := parser.ParseFile(fset, "", src, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fs = append(fs, f)
+	}
+	conf := types.Config{Importer: imports}
+	if _, err := conf.Check("generated", fset, fs, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestParamsCollidingWithGeneratedNames(t *testing.T) {
+	s, err := spec.Parse([]byte(`
+info:
+  title: Pets
+paths:
+  /pets/{ctx}:
+    put:
+      operationId: updatePet
+      parameters:
+        - {name: ctx, in: path, required: true, schema: {type: string}}
+        - {name: u, in: query, schema: {type: string}}
+        - {name: res, in: query, schema: {type: integer}}
+        - {name: resp, in: header, schema: {type: string}}
+        - {name: data, in: query, schema: {type: boolean}}
+        - {name: model, in: query, schema: {type: string}}
+        - {name: dest, in: query, schema: {type: string}}
+        - {name: body, in: query, schema: {type: string}}
+        - {name: type, in: query, schema: {type: string}}
+        - {name: strings, in: query, schema: {type: string}}
+        - {name: err, in: query, schema: {type: string}}
+        - {name: trace, in: header, schema: {type: string}}
+        - {name: trace, in: query, schema: {type: string}}
+      requestBody:
+        content:
+          application/json:
+            schema:
+              properties:
+                name:
+                  type: string
+      responses:
+        '200':
+          description: pet
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, kind := range []string{"client", "server"} {
+		src, err := Generate(kind, s, Options{PackageName: "generated"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		files := []string{string(src)}
+		if kind == "client" {
+			mocks, err := Generate("mocks", s, Options{PackageName: "generated"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			files = append(files, string(mocks))
+		}
+		typeCheck(t, files...)
+		assert.Contains(t, string(src), "UpdatePet(ctx context.Context, ctxParam string, uParam string, resParam int64, "+
+			"respParam string, dataParam bool, modelParam string, destParam string, bodyParam string, typeParam string, "+
+			"stringsParam string, errParam string, trace string, traceParam string, body UpdatePetRequest)", kind)
+	}
+}
+
+func TestEnumConstantsAreUnique(t *testing.T) {
+	s, err := spec.Parse([]byte(`
+info:
+  title: Items
+paths:
+  /items:
+    get:
+      operationId: listItems
+      parameters:
+        - name: level
+          in: query
+          schema:
+            $ref: "#/components/schemas/Level"
+      responses:
+        '200':
+          description: items
+          content:
+            application/json:
+              schema:
+                $ref: "#/components/schemas/Item"
+components:
+  schemas:
+    Level:
+      type: integer
+      enum: [-1, 1]
+    Item:
+      properties:
+        status:
+          type: string
+          enum: [in-stock, In Stock, "", "!", "?", InStock1]
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, kind := range []string{"client", "server"} {
+		src, err := Generate(kind, s, Options{PackageName: "generated"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		typeCheck(t, string(src))
+		for _, c := range []string{
+			"LevelMinus1 Level = -1",
+			"Level1      Level = 1",
+			`ItemStatusInStock   ItemStatus = "in-stock"`,
+			`ItemStatusInStock1  ItemStatus = "In Stock"`,
+			`ItemStatusEmpty     ItemStatus = ""`,
+			`ItemStatusEmpty3    ItemStatus = "!"`,
+			`ItemStatusEmpty4    ItemStatus = "?"`,
+			`ItemStatusInStock15 ItemStatus = "InStock1"`,
+		} {
+			assert.Contains(t, string(src), c, kind)
+		}
+	}
+}
+
+func TestNumberFormats(t *testing.T) {
+	client := generateFile(t, "testdata/formats.yaml", "client", Options{PackageName: "generated"})
+	server := generateFile(t, "testdata/formats.yaml", "server", Options{PackageName: "generated"})
+	typeCheck(t, client)
+	typeCheck(t, server)
+
+	for _, field := range []string{
+		"Id       int8 ", "Small    int16 ", "Count    uint16 ", "Size     uint32 ", "Total    uint64 ",
+		"Unsigned uint ", "Plain    int ", "Ratio    float32 ", "Precise  float64 ",
+	} {
+		assert.Contains(t, client, field)
+		assert.Contains(t, server, field)
+	}
+	assert.Contains(t, client, "PutMeasure(ctx context.Context, id int8, small int16, count uint16, total uint64, "+
+		"unsigned uint, plain int, ratio float32, precise float64, xSize uint32, body PutMeasureRequest)")
+
+	for _, s := range []string{
+		`"{id}", strconv.FormatInt(int64(id), 10)`,
+		`q.Set("small", strconv.FormatInt(int64(small), 10))`,
+		`q.Set("count", strconv.FormatUint(uint64(count), 10))`,
+		`q.Set("total", strconv.FormatUint(total, 10))`,
+		`q.Set("unsigned", strconv.FormatUint(uint64(unsigned), 10))`,
+		`q.Set("ratio", strconv.FormatFloat(float64(ratio), 'f', -1, 32))`,
+		`q.Set("precise", strconv.FormatFloat(precise, 'f', -1, 64))`,
+		`request.Header.Set("X-Size", strconv.FormatUint(uint64(xSize), 10))`,
+	} {
+		assert.Contains(t, client, s)
+	}
+	for _, s := range []string{
+		"v, err = strconv.ParseInt(value, 10, 8)",
+		"v, err = strconv.ParseInt(value, 10, 16)",
+		"v, err = strconv.ParseUint(value, 10, 16)",
+		"total, err = strconv.ParseUint(value, 10, 64)",
+		"v, err = strconv.ParseUint(value, 10, 0)",
+		"v, err = strconv.ParseInt(value, 10, 0)",
+		"v, err = strconv.ParseFloat(value, 32)",
+		"precise, err = strconv.ParseFloat(value, 64)",
+		"v, err = strconv.ParseUint(value, 10, 32)",
+	} {
+		assert.Contains(t, server, s)
+	}
+}
+
+func TestCustomGoTypes(t *testing.T) {
+	s, err := spec.Parse([]byte(`
+components:
+  schemas:
+    Payment:
+      required: [id]
+      properties:
+        id:
+          type: string
+          format: uuid
+          minLength: 36
+        amount:
+          type: string
+          x-go-type: decimal.Decimal
+          x-go-type-import: github.com/shopspring/decimal
+          minLength: 1
+        created:
+          type: string
+          x-go-type: pgtype.Timestamp
+          x-go-type-import: github.com/jackc/pgx/v5/pgtype
+        meta:
+          type: object
+          x-go-type: yamlv2.MapSlice
+          x-go-type-import: gopkg.in/yaml.v2
+          minProperties: 1
+        raw:
+          type: object
+          x-go-type: json.RawMessage
+        at:
+          type: string
+          format: timestamptz
+        note:
+          type: string
+          minLength: 1
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	src, err := Generate("dto", s, Options{PackageName: "dto", Validator: "native", TypeMap: map[string]string{
+		"uuid":        "github.com/gofrs/uuid/v5.UUID",
+		"timestamptz": "*github.com/jackc/pgx/v5/pgtype.Timestamptz",
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, s := range []string{
+		"Amount  decimal.Decimal ",
+		"At      *pgtype.Timestamptz ",
+		"Created pgtype.Timestamp ",
+		"Id      uuid.UUID ",
+		"Meta    yamlv2.MapSlice ",
+		"Raw     json.RawMessage ",
+		"Note    string ",
+		"\t\"encoding/json\"\n",
+		"\t\"github.com/gofrs/uuid/v5\"\n",
+		"\t\"github.com/jackc/pgx/v5/pgtype\"\n",
+		"\t\"github.com/shopspring/decimal\"\n",
+		"\tyamlv2 \"gopkg.in/yaml.v2\"\n",
+	} {
+		assert.Contains(t, string(src), s)
+	}
+
+	// Validation keywords are dropped for custom types, they are validated by themselves.
+	assert.Contains(t, string(src), `utf8.RuneCountInString(r.Note) < 1`)
+	assert.NotContains(t, string(src), "RuneCountInString(r.Id)")
+	assert.NotContains(t, string(src), "RuneCountInString(r.Amount)")
+	assert.NotContains(t, string(src), "len(r.Meta)")
+}
+
+func TestCustomParamTypes(t *testing.T) {
+	s, err := spec.Parse([]byte(`
+info:
+  title: Hosts
+paths:
+  /hosts/{addr}:
+    get:
+      operationId: getHost
+      parameters:
+        - name: addr
+          in: path
+          required: true
+          schema: {type: string, x-go-type: netip.Addr, x-go-type-import: net/netip}
+        - {name: since, in: query, schema: {type: string, x-go-type: time.Time}}
+        - {name: amount, in: query, schema: {type: string, format: bigint}}
+        - {name: amounts, in: query, schema: {type: array, items: {type: string, format: bigint}}}
+      responses:
+        '204':
+          description: host
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, o := range []Options{
+		{PackageName: "generated", TypeMap: map[string]string{"bigint": "*math/big.Int"}},
+		{PackageName: "generated", TypeMap: map[string]string{"bigint": "*math/big.Int"}, Optional: OptionalGeneric},
+	} {
+		src, err := Generate("server", s, o)
+		if err != nil {
+			t.Fatal(err)
+		}
+		typeCheck(t, string(src))
+	}
+
+	// Pointers are allocated before decoding into them.
+	server, err := Generate("server", s, Options{PackageName: "generated", TypeMap: map[string]string{"bigint": "*math/big.Int"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertOrder(t, string(server), "amount = new(big.Int)", "err = amount.UnmarshalText([]byte(value))",
+		"item = new(big.Int)", "err = item.UnmarshalText([]byte(v))")
+}
+
+func TestCustomParamTypesWithoutUnmarshalText(t *testing.T) {
+	s, err := spec.Parse([]byte(`
+info:
+  title: Hosts
+paths:
+  /hosts:
+    get:
+      operationId: listHosts
+      parameters:
+        - {name: timeout, in: query, schema: {type: string, x-go-type: time.Duration}}
+        - {name: name, in: query, schema: {type: string, x-go-type: string}}
+        - {name: meta, in: query, schema: {type: string, x-go-type: yamlv2.MapSlice, x-go-type-import: gopkg.in/yaml.v2}}
+        - {name: raw, in: query, schema: {type: string, format: raw}}
+        - {name: addr, in: query, schema: {type: string, x-go-type: netip.Addr, x-go-type-import: net/netip}}
+      responses:
+        '204':
+          description: hosts
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = Generate("server", s, Options{PackageName: "generated", TypeMap: map[string]string{"raw": "[]byte"}})
+	ds, ok := err.(spec.Diagnostics)
+	if !ok {
+		t.Fatalf("expected diagnostics, got %v", err)
+	}
+	messages := []string{}
+	for _, d := range ds {
+		messages = append(messages, d.Message)
+	}
+	assert.Equal(t, []string{
+		"type time.Duration of the parameter timeout doesn't implement encoding.TextUnmarshaler",
+		"type string of the parameter name doesn't implement encoding.TextUnmarshaler",
+		"type yamlv2.MapSlice of the parameter meta doesn't implement encoding.TextUnmarshaler",
+		"type []byte of the parameter raw doesn't implement encoding.TextUnmarshaler",
+	}, messages)
+}
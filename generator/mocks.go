@@ -0,0 +1,38 @@
+package generator
+
+import "github.com/oasgo/oasgo/spec"
+
+// MocksTemplate renders the mock of the client interface. Mocks are generated into the package of the client.
+const MocksTemplate = `
+// Code generated by https://github.com/oasgo/oasgo. DO NOT EDIT.
+// Source: {{ .Info.Title }} Version: {{ .Info.Version }}
+
+package {{.PackageName}}
+
+{{ $iName := (printf (goName .Info.Title false)) }}
+{{ $mName := (printf "%sMock" $iName) }}
+
+var _ {{ $iName }} = new({{ $mName }})
+
+// {{ $mName }} is a mock of {{ $iName }}. Methods call the functions of the fields and panic if they are not set.
+type {{ $mName }} struct {
+	{{- range $f := $.SortedFunctions }}
+	{{ $f.Name }}Func {{ $f.RenderFuncType }}
+	{{- end }}
+}
+
+{{- range $f := $.SortedFunctions }}
+
+func (m *{{ $mName }}) {{ $f.RenderSignature }} {
+	if m.{{ $f.Name }}Func == nil {
+		panic("{{ $mName }}.{{ $f.Name }} is not set")
+	}
+	return m.{{ $f.Name }}Func(ctx{{ range $p := $f.Input }}, {{ $p.ArgName }}{{ end }})
+}
+{{- end }}
+`
+
+// Mocks generates the mock of the client interface of the specification.
+func Mocks(s *spec.Swagger, o Options) ([]byte, error) {
+	return generate("mocks", MocksTemplate, s, o)
+}
@@ -0,0 +1,256 @@
+package generator
+
+import (
+	"fmt"
+	"go/types"
+
+	"github.com/oasgo/oasgo/spec"
+)
+
+const (
+	GET OperationType = iota
+	POST
+	PUT
+	PATCH
+	DELETE
+	HEAD
+	OPTIONS
+	TRACE
+)
+
+var operationTypeValues = []string{"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS", "TRACE"}
+
+type OperationType int
+
+type Reference interface {
+	RenderDefinition(isAbbreviate bool) string
+	RenderLiteral() string
+	RenderName(isAbbreviate bool) string
+	RenderExtraction(to, that, field string) string
+	RenderFormat() string
+	RenderCheckEmpty(name string) string
+	RenderToString(name string) string
+	RenderMethods(isAbbreviate bool) string
+}
+
+type Context struct {
+	PackageName  string
+	Info         spec.Info
+	IsAbbreviate bool
+	// Validator is a kind of generated Validate methods: govalidator or native.
+	Validator  string
+	References map[string]Property
+	Functions  []Function
+
+	building         map[string]bool
+	lenientEnums     bool
+	optionalStrategy string
+	typeMap          map[string]string
+	// imports of the mapped Go types by paths to package names.
+	imports     map[string]string
+	swagger     *spec.Swagger
+	diagnostics spec.Diagnostics
+	// names of the referenced schemas by their locations and locations of the schemas by names of their types.
+	names  map[string]string
+	owners map[string]string
+	// tags and operations filter operations.
+	tags       []string
+	operations []string
+	// keepOrder keeps the declaration order of struct fields and operations.
+	keepOrder bool
+	// importer loads packages of custom types of parameters to check them.
+	importer types.Importer
+}
+type Function struct {
+	Name          string
+	Path          string
+	OperationType OperationType
+	Input         []Param
+	Output        []Param
+	// ContentTypes of the request body accepted by the validator
+	ContentTypes []string
+	// OtherContentTypes of the request body aren't JSON, so their bodies aren't decoded.
+	OtherContentTypes []string
+	// BodyRequired is set if the request body is required.
+	BodyRequired bool
+}
+
+type Param struct {
+	In       string
+	Required bool
+	Property Property
+}
+
+type Struct struct {
+	Properties []Property
+	Name       string
+	AbbrName   string
+	Desc       string
+	// Ordered struct keeps Properties in the declaration order instead of sorting them by names.
+	Ordered bool
+}
+
+// Union is a oneOf/anyOf schema rendered as a sealed interface wrapped into a struct.
+type Union struct {
+	Name          string
+	AbbrName      string
+	Desc          string
+	Exclusive     bool
+	Discriminator string
+	Variants      []Property
+	Cases         []UnionCase
+}
+
+// UnionCase binds a discriminator value to the Union variant.
+type UnionCase struct {
+	Value   string
+	Variant Property
+}
+
+// Pointer refers to the type by pointer e.g. to break recursive struct definitions.
+type Pointer struct {
+	Reference Reference
+}
+
+// Generic wraps the type into the generated Optional or Nullable type.
+type Generic struct {
+	Name      string
+	Reference Reference
+}
+
+// Strategies of rendering optional and nullable fields.
+const (
+	// OptionalValue renders optional fields as values and nullable fields as pointers.
+	OptionalValue = "value"
+	// OptionalPointer renders optional and nullable fields as pointers.
+	OptionalPointer = "pointer"
+	// OptionalGeneric renders optional fields as Optional[T] and nullable fields as Nullable[T].
+	OptionalGeneric = "generic"
+)
+
+type Slice struct {
+	ItemsType Property
+	Name      string
+}
+
+type Dictionary struct {
+	ItemsType Property
+	Name      string
+}
+
+type String struct {
+	Values  []string
+	Default string
+	Format  string
+}
+
+// Enum is a named type of string or integer with a constant for every value.
+type Enum struct {
+	Name     string
+	AbbrName string
+	Desc     string
+	// Base is a Go type of values: string or integer type of the Format.
+	Base   string
+	Format string
+	Values []string
+	// Lenient enum accepts unknown values on decoding.
+	Lenient bool
+}
+
+// EnumConstant is a constant of the Enum value.
+type EnumConstant struct {
+	Name  string
+	Value string
+}
+
+// Integer is an integer of the Go type matching the format e.g.: int32 for "int32".
+type Integer struct {
+	Format string
+}
+
+// Number is a float64 or a float32 for the "float" format.
+type Number struct {
+	Format string
+}
+
+// integerTypes maps formats of integers to Go types with their bit sizes. Unknown formats are int64.
+var integerTypes = map[string]struct {
+	Type    string
+	BitSize int
+}{
+	"int":    {"int", 0},
+	"int8":   {"int8", 8},
+	"int16":  {"int16", 16},
+	"int32":  {"int32", 32},
+	"int64":  {"int64", 64},
+	"uint":   {"uint", 0},
+	"uint8":  {"uint8", 8},
+	"uint16": {"uint16", 16},
+	"uint32": {"uint32", 32},
+	"uint64": {"uint64", 64},
+}
+
+type Bool struct{}
+
+type Datetime struct {
+	Format string
+}
+
+// Custom is an existing Go type mapped by x-go-type or by the type map.
+// Custom types are validated by themselves and used as parameters via encoding.TextUnmarshaler.
+type Custom struct {
+	Type string
+	// Import is the import path of the package of the type, empty for types without paths e.g.: json.RawMessage.
+	Import string
+}
+
+type Property struct {
+	Name          string
+	SourceName    string
+	Reference     Reference
+	Required      bool
+	Enum          []string
+	ExtensionTags map[string][]string
+	Validation    spec.Validation
+	Nullable      bool
+}
+
+type functions []Function
+type propertiesByLiteral []Property
+type propertiesByName []Property
+type paramsByStatus []Param
+
+// Options of the code generation.
+type Options struct {
+	PackageName  string
+	IsAbbreviate bool
+	// Validator is a kind of generated Validate methods: govalidator or native.
+	Validator string
+	// LenientEnums makes decoding of unknown enum values successful.
+	LenientEnums bool
+	// Optional is a strategy of rendering optional and nullable fields: value, pointer or generic.
+	Optional string
+	// TypeMap maps formats to qualified Go types e.g.: uuid to github.com/google/uuid.UUID
+	TypeMap map[string]string
+	// Tags and Operations select operations by their tags or IDs. All operations are generated if both are empty.
+	Tags       []string
+	Operations []string
+	// KeepOrder keeps the declaration order of struct fields and operations instead of sorting them by names.
+	// Enum constants always follow the declaration order.
+	KeepOrder bool
+}
+
+// Validate checks values of the options.
+func (o Options) Validate() error {
+	switch o.Validator {
+	case "", "govalidator", "native":
+	default:
+		return fmt.Errorf("unsupported validator %s", o.Validator)
+	}
+	switch o.Optional {
+	case "", OptionalValue, OptionalPointer, OptionalGeneric:
+	default:
+		return fmt.Errorf("unsupported optional strategy %s", o.Optional)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package generator
+
+import (
+	"path"
+	"strings"
+
+	"github.com/oasgo/oasgo/spec"
+)
+
+// nameReferences names the referenced schemas by their locations, so schemas of different documents with the same name
+// get different Go types. A schema is named after its reference e.g.: Money, the schema of another document is prefixed
+// by the name of the document e.g.: CommonMoney if schemas of several locations have the same name.
+// Schemas of the root document keep their names.
+func (ctx *Context) nameReferences(s *spec.Swagger) {
+	// Locations by names of the schemas and the reference of every location.
+	locations := map[string][]string{}
+	refs := map[string]string{}
+	add := func(key, ref string) {
+		if _, ok := refs[key]; ok || key == "" {
+			return
+		}
+		refs[key] = ref
+		name := getRefName(ref)
+		locations[name] = append(locations[name], key)
+	}
+
+	for _, n := range spec.SortedKeys(s.Components.Schemas) {
+		add(ctx.identity(s.Components.Schemas[n]), "#/components/schemas/"+n)
+	}
+	spec.Inspect(*s, func(n interface{}) bool {
+		schema, ok := n.(*spec.Schema)
+		if !ok || schema == nil || schema.Ref == "" {
+			return true
+		}
+		key := ctx.identity(schema)
+		if _, ok := refs[key]; ok {
+			return false
+		}
+		add(key, schema.Ref)
+		return true
+	})
+
+	for _, name := range spec.SortedKeys(locations) {
+		keys := locations[name]
+		for _, key := range keys {
+			ctx.names[key] = name
+			if len(keys) > 1 && !strings.HasPrefix(key, s.File()+"#") {
+				file := strings.SplitN(key, "#", 2)[0]
+				ctx.names[key] = ToCamelCase(true, strings.TrimSuffix(path.Base(file), path.Ext(file))) + name
+			}
+		}
+	}
+}
+
+// refName returns the Go name of the schema referenced by the ref or empty string if it is not a reference.
+func (ctx *Context) refName(schema *spec.Schema, ref string) string {
+	if ref == "" {
+		return ""
+	}
+	if name, ok := ctx.names[ctx.identity(schema)]; ok {
+		return name
+	}
+	return getRefName(ref)
+}
+
+// identity returns the location of the schema e.g.: common.yaml#/components/schemas/Money.
+// A resolved reference has the location of the referenced schema.
+func (ctx *Context) identity(schema *spec.Schema) string {
+	l := ctx.swagger.Locate(schema)
+	if l.Pointer == "" {
+		return ""
+	}
+	return l.File + l.Pointer
+}
+
+// define registers the named type of the schema. Types of different schemas with the same name are reported.
+func (ctx *Context) define(name string, p Property, schema *spec.Schema) {
+	ctx.claim(name, schema)
+	ctx.References[name] = p
+}
+
+// claim reports the schema which has the same name as the type of another schema.
+func (ctx *Context) claim(name string, schema *spec.Schema) {
+	key := ctx.identity(schema)
+	if key == "" {
+		return
+	}
+	if owner, ok := ctx.owners[name]; ok && owner != key {
+		ctx.fail(schema, "type %s of the schema has the same name as the type of %s", name, owner)
+		return
+	}
+	ctx.owners[name] = key
+}
+
+// getRefName returns last element from ref string e.g.: "#/components/schemas/Pet"
+// or the file name for references to whole documents e.g.: "./schemas/pet.yaml"
+func getRefName(ref string) string {
+	if location, pointer := spec.SplitRef(ref); location != "" && pointer == "" {
+		name := path.Base(location)
+		return ToCamelCase(true, strings.TrimSuffix(name, path.Ext(name)))
+	}
+	path := strings.Split(ref, "/")
+	return path[len(path)-1]
+}
@@ -0,0 +1,78 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/oasgo/oasgo/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExternalSchemaWithLocalName(t *testing.T) {
+	c := newContext(t, "../testdata/refs/api.yaml")
+
+	assert.Equal(t, []string{"Cents"}, propertyNames(t, c, "Money"))
+	assert.Equal(t, []string{"Amount", "Currency"}, propertyNames(t, c, "CommonMoney"))
+
+	types := map[string]string{}
+	for _, p := range c.References["Order"].Reference.(*Struct).Properties {
+		types[p.Name] = p.Reference.RenderName(false)
+	}
+	assert.Equal(t, map[string]string{"Price": "CommonMoney", "Discount": "Money", "Customer": "Customer"}, types)
+}
+
+func TestSameNameOfDifferentSchemas(t *testing.T) {
+	s, err := spec.Load("../testdata/refs/collision.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = NewContext(s, Options{})
+	ds, ok := err.(spec.Diagnostics)
+	if !ok || len(ds) != 1 {
+		t.Fatalf("expected one diagnostic, got %v", err)
+	}
+	assert.Equal(t, "#/components/schemas/PetOwner", ds[0].Location.Pointer)
+	assert.Contains(t, ds[0].Message, "type PetOwner of the schema has the same name as the type of")
+	assert.Contains(t, ds[0].Message, "#/components/schemas/Pet/properties/owner")
+}
+
+func newContext(t *testing.T, path string) Context {
+	s, err := spec.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewContext(s, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func propertyNames(t *testing.T, c Context, name string) []string {
+	r, ok := c.References[name]
+	if !ok {
+		t.Fatalf("no type %s", name)
+	}
+	names := []string{}
+	for _, p := range r.Reference.(*Struct).SortedProperties() {
+		names = append(names, p.Name)
+	}
+	return names
+}
+
+func TestRecursiveSchemas(t *testing.T) {
+	c := newContext(t, "../testdata/refs/tree.yaml")
+
+	types := map[string]string{}
+	for _, p := range c.References["TreeNode"].Reference.(*Struct).Properties {
+		types[p.Name] = p.Reference.RenderName(false)
+	}
+	assert.Equal(t, "*TreeNode", types["Parent"])
+	assert.Equal(t, "[]TreeNode", types["Children"])
+	assert.Equal(t, "A", types["A"])
+	assert.Equal(t, "TreeNodeMeta", types["Meta"])
+	assert.Equal(t, "*TreeNode", c.References["TreeNodeMeta"].Reference.(*Struct).Properties[0].Reference.RenderName(false))
+
+	// The cycle of A and B is broken by the pointer of the type built last.
+	assert.Equal(t, "B", c.References["A"].Reference.(*Struct).Properties[0].Reference.RenderName(false))
+	assert.Equal(t, "*A", c.References["B"].Reference.(*Struct).Properties[0].Reference.RenderName(false))
+}
@@ -0,0 +1,273 @@
+package generator
+
+import "github.com/oasgo/oasgo/spec"
+
+const ServerTemplate = `
+// Code generated by https://github.com/oasgo/oasgo. DO NOT EDIT.
+// Source: {{ .Info.Title }} Version: {{ .Info.Version }}
+
+// Package {{.PackageName}} is a generated OASGO package.
+
+package {{.PackageName}}
+
+type (
+	// ServerInterface is implemented by the service and served by the Handler.
+	ServerInterface interface {
+		{{- range $f := $.SortedFunctions }}
+			{{$f.RenderSignature}}
+		{{- end -}}
+	}
+	{{ range $f := $.SortedFunctions }}
+		{{- $f.RenderServerResponse }}
+	{{- end }}
+
+	{{ range $r := $.SortedReferences }}
+		{{$r.Reference.RenderDefinition $.IsAbbreviate}}
+	{{ end }}
+)
+{{ range $r := $.SortedReferences }}
+	{{- $r.Reference.RenderMethods $.IsAbbreviate }}
+{{- end }}
+
+// Handler routes requests by the path template and the method to the ServerInterface.
+type Handler struct {
+	Server ServerInterface
+	routes []route
+}
+
+type route struct {
+	method   string
+	segments []string
+	handle   func(w http.ResponseWriter, r *http.Request, pathParams map[string]string)
+	validate func(r *http.Request, pathParams map[string]string) []error
+}
+
+var _ http.Handler = new(Handler)
+
+func NewHandler(s ServerInterface) *Handler {
+	h := &Handler{Server: s}
+	h.routes = newRoutes(h)
+	return h
+}
+
+func newRoutes(h *Handler) []route {
+	routes := []route{
+		{{- range $f := $.SortedFunctions }}
+		{ {{ printf "%q" $f.OperationType.String }}, {{ $f.RenderRoute }}, h.handle{{ $f.Name }}, validate{{ $f.Name }}Request },
+		{{- end }}
+	}
+	// Static segments take precedence over parameters e.g.: "/pets/mine" over "/pets/{petId}".
+	sort.SliceStable(routes, func(i, j int) bool {
+		a, b := routes[i].segments, routes[j].segments
+		for k := 0; k < len(a) && k < len(b); k++ {
+			if isPathParam(a[k]) != isPathParam(b[k]) {
+				return !isPathParam(a[k])
+			}
+		}
+		return false
+	})
+	return routes
+}
+
+// findRoute returns the route of the request and its path parameters.
+// Methods of the routes matching the path are returned if there is no route for the request method.
+func findRoute(routes []route, r *http.Request) (*route, map[string]string, []string) {
+	segments := strings.Split(strings.Trim(r.URL.EscapedPath(), "/"), "/")
+
+	allowed := []string{}
+	for i, rt := range routes {
+		pathParams, ok := rt.match(segments)
+		if !ok {
+			continue
+		}
+		if rt.method != r.Method {
+			allowed = append(allowed, rt.method)
+			continue
+		}
+		return &routes[i], pathParams, nil
+	}
+	return nil, nil, allowed
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rt, pathParams, allowed := findRoute(h.routes, r)
+	if rt != nil {
+		rt.handle(w, r, pathParams)
+		return
+	}
+
+	if len(allowed) > 0 {
+		w.Header().Set("Allow", strings.Join(allowed, ", "))
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s is not allowed", r.Method))
+		return
+	}
+	writeError(w, http.StatusNotFound, fmt.Errorf("path %s is not found", r.URL.Path))
+}
+
+// match matches the escaped path segments against the route and returns unescaped path parameters.
+func (rt route) match(segments []string) (map[string]string, bool) {
+	if len(segments) != len(rt.segments) {
+		return nil, false
+	}
+	pathParams := map[string]string{}
+	for i, s := range rt.segments {
+		if !isPathParam(s) {
+			if s != segments[i] {
+				return nil, false
+			}
+			continue
+		}
+		value, err := url.PathUnescape(segments[i])
+		if err != nil {
+			return nil, false
+		}
+		pathParams[strings.Trim(s, "{}")] = value
+	}
+	return pathParams, true
+}
+
+func isPathParam(segment string) bool {
+	return strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+}
+
+// Validator is a middleware which checks requests against the spec before passing them to the next handler.
+// Requests with violations are answered with 400 status code and the list of every failing field.
+type Validator struct {
+	next   http.Handler
+	routes []route
+}
+
+var _ http.Handler = new(Validator)
+
+func NewValidator(next http.Handler) *Validator {
+	return &Validator{
+		next:   next,
+		routes: newRoutes(new(Handler)),
+	}
+}
+
+func (v *Validator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Unknown routes are left to the next handler.
+	rt, pathParams, _ := findRoute(v.routes, r)
+	if rt == nil {
+		v.next.ServeHTTP(w, r)
+		return
+	}
+
+	errs := rt.validate(r, pathParams)
+	if len(errs) == 0 {
+		v.next.ServeHTTP(w, r)
+		return
+	}
+
+	res := ValidationErrorResponse{Message: "invalid request"}
+	for _, err := range errs {
+		fe := FieldError{Message: err.Error()}
+		if f, ok := err.(interface{ Field() string }); ok {
+			fe.Field = f.Field()
+		}
+		res.Errors = append(res.Errors, fe)
+	}
+	writeJSON(w, http.StatusBadRequest, res)
+}
+
+// ValidationErrorResponse is written by the Validator for invalid requests.
+type ValidationErrorResponse struct {
+	Message string       ` + "`" + `json:"message"` + "`" + `
+	Errors  []FieldError ` + "`" + `json:"errors"` + "`" + `
+}
+
+// FieldError describes the violation of the request field.
+type FieldError struct {
+	Field   string ` + "`" + `json:"field"` + "`" + `
+	Message string ` + "`" + `json:"message"` + "`" + `
+}
+
+func isContentTypeAllowed(contentType string, allowed []string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	for _, el := range allowed {
+		if strings.EqualFold(mediaType, el) {
+			return true
+		}
+	}
+	return false
+}
+
+{{- range $f := $.SortedFunctions }}
+{{ $f.RenderExtractParams }}
+{{ $f.RenderValidateRequest }}
+{{ $f.RenderHandler }}
+{{- end }}
+
+{{- $.RenderOptionalTypes }}
+{{- $.RenderDiscriminatorHelper }}
+
+// MissingParameterError is returned when a required parameter is not set in the request.
+type MissingParameterError struct {
+	field string
+}
+
+func (e *MissingParameterError) Error() string {
+	return fmt.Sprintf("missing required parameter %q", e.field)
+}
+
+// Field returns name of the missing parameter.
+func (e *MissingParameterError) Field() string {
+	return e.field
+}
+
+// InvalidParameterTypeError is returned when a parameter can't be converted to its type.
+type InvalidParameterTypeError struct {
+	field    string
+	original error
+}
+
+func (e *InvalidParameterTypeError) Error() string {
+	return fmt.Sprintf("invalid parameter %q: %s", e.field, e.original)
+}
+
+// Field returns name of the invalid parameter.
+func (e *InvalidParameterTypeError) Field() string {
+	return e.field
+}
+
+func (e *InvalidParameterTypeError) Unwrap() error {
+	return e.original
+}
+
+func cookieValue(r *http.Request, name string) string {
+	c, err := r.Cookie(name)
+	if err != nil {
+		return ""
+	}
+	return c.Value
+}
+
+// statusCode returns the code if it is set or the fallback one.
+func statusCode(code, fallback int) int {
+	if code == 0 {
+		return fallback
+	}
+	return code
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	writeJSON(w, code, struct {
+		Message string ` + "`" + `json:"message"` + "`" + `
+	}{err.Error()})
+}
+`
+
+// Server generates the server interface of the specification and the HTTP handler serving it.
+func Server(s *spec.Swagger, o Options) ([]byte, error) {
+	return generate("server", ServerTemplate, s, o)
+}
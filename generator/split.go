@@ -0,0 +1,152 @@
+package generator
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"sort"
+	"strings"
+)
+
+// split splits the formatted source of the kind into files by declarations:
+// types.go with types of the components, enums.go with enums and <kind>.go with the rest e.g.: client.go.
+// Methods, functions and constants go to the files of their types, files get the header of the source.
+// Empty files are omitted but the package always has at least the file of the rest.
+func split(kind string, src []byte, c Context) (map[string][]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, sourceError(src, err)
+	}
+	offset := func(p token.Pos) int { return fset.Position(p).Offset }
+	text := func(from, to token.Pos) string { return string(src[offset(from):offset(to)]) }
+
+	rest := kind + ".go"
+	owners := map[string]string{}
+	for _, r := range c.SortedReferences() {
+		file := "types.go"
+		if _, ok := r.Reference.(*Enum); ok {
+			file = "enums.go"
+		}
+		owners[r.Reference.RenderName(c.IsAbbreviate)] = file
+	}
+	fileOf := func(name string) string {
+		if file, ok := owners[name]; ok {
+			return file
+		}
+		return rest
+	}
+
+	// Type specs are collected into the type declaration of the file, other declarations follow it.
+	types := map[string][]string{}
+	decls := map[string][]string{}
+	for _, d := range f.Decls {
+		switch d := d.(type) {
+		case *ast.FuncDecl:
+			// Functions go with the types they return e.g.: constructors or AllPetKindValues.
+			file := rest
+			if d.Recv != nil && len(d.Recv.List) > 0 {
+				file = fileOf(typeName(d.Recv.List[0].Type))
+			} else if r := d.Type.Results; r != nil && len(r.List) > 0 {
+				t := r.List[0].Type
+				if a, ok := t.(*ast.ArrayType); ok {
+					t = a.Elt
+				}
+				file = fileOf(typeName(t))
+			}
+			decls[file] = append(decls[file], text(docStart(d.Doc, d.Pos()), d.End()))
+		case *ast.GenDecl:
+			switch {
+			case d.Tok == token.IMPORT:
+			case d.Tok == token.TYPE:
+				for _, s := range d.Specs {
+					ts := s.(*ast.TypeSpec)
+					end := ts.End()
+					if ts.Comment != nil {
+						end = ts.Comment.End()
+					}
+					file := fileOf(ts.Name.Name)
+					types[file] = append(types[file], text(docStart(ts.Doc, ts.Pos()), end))
+				}
+			default:
+				// Constants and variables are kept in their groups e.g.: values of the enum.
+				file := rest
+				if vs, ok := d.Specs[0].(*ast.ValueSpec); ok {
+					if vs.Type != nil {
+						file = fileOf(typeName(vs.Type))
+					} else {
+						file = fileOf(vs.Names[0].Name)
+					}
+				}
+				decls[file] = append(decls[file], text(docStart(d.Doc, d.Pos()), d.End()))
+			}
+		}
+	}
+
+	names := []string{}
+	for file := range types {
+		names = append(names, file)
+	}
+	for file := range decls {
+		if _, ok := types[file]; !ok {
+			names = append(names, file)
+		}
+	}
+	sort.Strings(names)
+	if len(names) == 0 {
+		names = []string{rest}
+	}
+
+	header := text(token.Pos(fset.File(f.Package).Base()), f.Package)
+	files := map[string][]byte{}
+	for _, file := range names {
+		b := strings.Builder{}
+		b.WriteString(header)
+		b.WriteString("package " + f.Name.Name + "\n\n")
+		if ts := types[file]; len(ts) > 0 {
+			b.WriteString("type (\n" + strings.Join(ts, "\n\n") + "\n)\n\n")
+		}
+		b.WriteString(strings.Join(decls[file], "\n\n"))
+
+		out, err := formatSource([]byte(b.String()), c.imports)
+		if err != nil {
+			return nil, err
+		}
+		files[file] = out
+	}
+	return files, nil
+}
+
+// FileNames returns names of all files split can produce for the kind.
+// Mocks don't declare types of the components, they are in the package of the client.
+func FileNames(kind string) []string {
+	if kind == "mocks" {
+		return []string{"mocks.go"}
+	}
+	return []string{"enums.go", kind + ".go", "types.go"}
+}
+
+// typeName returns the name of the type expression e.g.: Pet of *Pet or Optional of Optional[T].
+func typeName(e ast.Expr) string {
+	switch t := e.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.StarExpr:
+		return typeName(t.X)
+	case *ast.IndexExpr:
+		return typeName(t.X)
+	case *ast.IndexListExpr:
+		return typeName(t.X)
+	case *ast.ParenExpr:
+		return typeName(t.X)
+	}
+	return ""
+}
+
+// docStart returns the position of the declaration including its doc comment.
+func docStart(doc *ast.CommentGroup, pos token.Pos) token.Pos {
+	if doc != nil {
+		return doc.Pos()
+	}
+	return pos
+}
@@ -0,0 +1,2332 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/importer"
+	"go/token"
+	"go/types"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/oasgo/oasgo/spec"
+)
+
+const (
+	structTemplate = `
+	{{- if $.IsAbbreviate}} 
+		//{{ $.P.Desc }}
+		{{ $.P.AbbrName }} 
+	{{- else -}} 
+		{{ $.P.Name -}} 
+	{{ end }} struct {
+		{{ range $p :=  $.P.SortedProperties }}
+			{{- $p.Name }} {{ $p.Reference.RenderName $.IsAbbreviate}} {{($.P.RenderTags $p)}}
+		{{ end }}
+	}
+	`
+	enumTemplate = `
+	{{- if $.IsAbbreviate}}
+		//{{ $.P.Desc }}
+	{{- end }}
+	{{ $.P.RenderName $.IsAbbreviate }} {{ $.P.Base }}
+	`
+	enumMethodsTemplate = `
+{{- $name := $.P.RenderName $.IsAbbreviate }}
+const (
+	{{- range $c := $.P.Constants $.IsAbbreviate }}
+	{{ $c.Name }} {{ $name }} = {{ $c.Value }}
+	{{- end }}
+)
+
+// IsValid reports whether the value is one of the {{ $name }} values.
+func (e {{ $name }}) IsValid() bool {
+	switch e {
+	case {{ range $i, $c := $.P.Constants $.IsAbbreviate }}{{ if $i }}, {{ end }}{{ $c.Name }}{{ end }}:
+		return true
+	}
+	return false
+}
+
+// All{{ $name }}Values returns all values of the {{ $name }}.
+func All{{ $name }}Values() []{{ $name }} {
+	return []{{ $name }}{
+		{{- range $c := $.P.Constants $.IsAbbreviate }}
+		{{ $c.Name }},
+		{{- end }}
+	}
+}
+{{ if eq $.P.Base "string" }}
+func (e {{ $name }}) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *{{ $name }}) UnmarshalText(data []byte) error {
+	v := {{ $name }}(data)
+	{{- if not $.P.Lenient }}
+	if !v.IsValid() {
+		return fmt.Errorf("{{ $name }}: unknown value %q", data)
+	}
+	{{- end }}
+	*e = v
+	return nil
+}
+{{- else }}
+func (e *{{ $name }}) UnmarshalJSON(data []byte) error {
+	var v {{ $.P.Base }}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("{{ $name }}: %s", err)
+	}
+	{{- if not $.P.Lenient }}
+	if !{{ $name }}(v).IsValid() {
+		return fmt.Errorf("{{ $name }}: unknown value %d", v)
+	}
+	{{- end }}
+	*e = {{ $name }}(v)
+	return nil
+}
+
+func (e *{{ $name }}) UnmarshalText(data []byte) error {
+	v, err := {{ $.P.RenderParse "string(data)" }}
+	if err != nil {
+		return fmt.Errorf("{{ $name }}: %s", err)
+	}
+	{{- if not $.P.Lenient }}
+	if !{{ $name }}(v).IsValid() {
+		return fmt.Errorf("{{ $name }}: unknown value %q", data)
+	}
+	{{- end }}
+	*e = {{ $name }}(v)
+	return nil
+}
+{{- end }}
+`
+	extractWrappedTemplate = `
+	{
+		var wv {{ $.Type }}
+		{{ $.Extraction }}
+		{{ $.Name }} = {{ $.Wrap }}
+	}
+`
+	optionalTypesTemplate = `
+// Optional is a value which may be absent. Absent value is omitted by JSON encoding of omitzero fields.
+type Optional[T any] struct {
+	Value T
+	Set   bool
+}
+
+// Some returns the Optional set to the value.
+func Some[T any](v T) Optional[T] {
+	return Optional[T]{Value: v, Set: true}
+}
+
+// Get returns the value and whether it is set.
+func (o Optional[T]) Get() (T, bool) {
+	return o.Value, o.Set
+}
+
+func (o Optional[T]) IsZero() bool {
+	return !o.Set
+}
+
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.Set {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.Value)
+}
+
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	o.Set = true
+	return json.Unmarshal(data, &o.Value)
+}
+
+// Nullable is a value which may be absent, null or set.
+type Nullable[T any] struct {
+	Value T
+	Set   bool
+	Null  bool
+}
+
+// NewNullable returns the Nullable set to the value.
+func NewNullable[T any](v T) Nullable[T] {
+	return Nullable[T]{Value: v, Set: true}
+}
+
+// Null returns the Nullable set to null.
+func Null[T any]() Nullable[T] {
+	return Nullable[T]{Set: true, Null: true}
+}
+
+// Get returns the value and whether it is set and not null.
+func (n Nullable[T]) Get() (T, bool) {
+	return n.Value, n.Set && !n.Null
+}
+
+func (n Nullable[T]) IsZero() bool {
+	return !n.Set
+}
+
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if !n.Set || n.Null {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Value)
+}
+
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	n.Set = true
+	if string(data) == "null" {
+		n.Null = true
+		var zero T
+		n.Value = zero
+		return nil
+	}
+	n.Null = false
+	return json.Unmarshal(data, &n.Value)
+}
+`
+	discriminatorTemplate = `
+// setDiscriminator sets the discriminator property of the encoded object to the value. The property is replaced
+// in its place or prepended, so other properties keep their order. Data other than objects e.g.: null is returned as is.
+func setDiscriminator(data []byte, property, value string) ([]byte, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	d := json.NewDecoder(bytes.NewReader(data))
+	if t, err := d.Token(); err != nil || t != json.Delim('{') {
+		return data, nil
+	}
+	for d.More() {
+		key, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		// The encoded object is compact, so the value follows the colon of the key.
+		start := d.InputOffset() + 1
+		var v json.RawMessage
+		if err := d.Decode(&v); err != nil {
+			return nil, err
+		}
+		if key == property {
+			out := append([]byte{}, data[:start]...)
+			out = append(out, encoded...)
+			return append(out, data[d.InputOffset():]...), nil
+		}
+	}
+	name, err := json.Marshal(property)
+	if err != nil {
+		return nil, err
+	}
+	out := append(append([]byte("{"), name...), ':')
+	out = append(out, encoded...)
+	if len(data) > len("{}") {
+		out = append(out, ',')
+	}
+	return append(out, data[1:]...), nil
+}
+`
+	extractEnumTemplate = `
+	{
+		var ev {{ $.Base }}
+		{{ $.Extraction }}
+		{{ $.Name }} = {{ $.Type }}(ev)
+		{{- if not $.Lenient }}
+		if !{{ $.Name }}.IsValid() {
+			err = &InvalidParameterTypeError{
+				field:    "{{ $.Field }}",
+				original: fmt.Errorf("unknown value %v", ev),
+			}
+			return
+		}
+		{{- end }}
+	}
+`
+	enumValidateTemplate = `if !r.IsValid() {
+		return false, fmt.Errorf("must be one of {{ $.Values }}")
+	}
+	return true, nil`
+	nativeEnumValidateTemplate = `if !r.IsValid() {
+		errs.Add(path, "must be one of {{ $.Values }}")
+	}`
+	arrayTemplate     = `{{$.Name}} []{{$.ItemsType.Reference.RenderName false}}`
+	dictTemplate      = `{{$.Name}} map[string]{{$.ItemsType.Reference.RenderName false}}`
+	signatureTemplate = `{{$.Name}} ( ctx context.Context,
+	{{- range $i, $p := $.Input }}
+		{{- if eq $p.In "body"}} body {{ else }} {{ $p.Property.Name }} {{ end -}}	
+		{{ $p.Property.Reference.RenderName false}} {{- if lt (inc $i) (len $.Input) -}}, {{- end -}}
+	{{- end -}}
+	)(*{{$.Name}}Response, error)`
+	responseTemplate = `
+	// {{$.Name}}Response is a response of {{$.Name}}.
+	{{$.Name}}Response struct {
+		HTTPResponse *http.Response
+		{{- range $o := $.Output }}
+		{{- if $o.Property.Reference }}
+		{{ $o.ResponseField }} *{{ $o.Property.Reference.RenderName false }}
+		{{- end }}
+		{{- end }}
+	}
+	`
+	funcBodyTemplate = `
+	u := *c.URL
+	{{ if $.HasPathParam }}
+		u.Path = strings.NewReplacer(
+			{{ $.RenderPathParams }}
+		).Replace("{{- $.Path -}}")
+	{{ else }}
+		u.Path = "{{- $.Path -}}"
+	{{ end }}
+	{{ if $.GetQueryParams }}
+		{{- $.RenderQueryParams }}
+	{{ end }}
+	{{$.RenderRequestBody}}
+`
+	pathParamsTemplate = `
+    {{- range $p := $.GetPathParams}}
+		{{- $p.RenderPathParam}}
+    {{- end }}
+
+`
+	queryParamsTemplate = `
+	q := u.Query()
+    {{ range $p := $.GetQueryParams}}
+		{{- $p.RenderQueryParam}}
+    {{- end }}
+    u.RawQuery = q.Encode()
+`
+	requestBodyTemplate = `
+	{{$body := $.GetBody}}
+	{{if $body}}
+		bs, err := json.Marshal(body)
+      	if err != nil {
+        	return nil, err
+      	}
+      	request, err := http.NewRequestWithContext(ctx, "{{$.OperationType.String}}", u.String(), bytes.NewBuffer(bs))
+  	{{- else}}
+      	request, err := http.NewRequestWithContext(ctx, "{{$.OperationType.String}}", u.String(), nil)
+	{{end}}
+	if err != nil {
+		return nil, err
+	}
+
+	{{ range $h := $.GetHeaders}}
+		{{- $h.RenderHeader}}
+	{{- end }}
+
+	resp, data, err := c.sendRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &{{$.Name}}Response{HTTPResponse: resp}
+	var model interface{}
+	{{- if $.Output }}
+	switch {
+	{{- range $o := $.Output }}
+	{{- if and (eq $o.In "default") $o.Property.Reference }}
+	case resp.StatusCode/100 == 2 && len(data) == 0:
+		// Undeclared success responses without content aren't decoded as the default one.
+	{{- end }}
+	{{ $o.RenderResponseCase }}:
+		{{- if $o.Property.Reference }}
+		var dest {{ $o.Property.Reference.RenderName false }}
+		if err := json.Unmarshal(data, &dest); err == nil {
+			res.{{ $o.ResponseField }} = &dest
+			model = res.{{ $o.ResponseField }}
+		} else if resp.StatusCode < http.StatusBadRequest {
+			return res, err
+		}
+		{{- end }}
+	{{- end }}
+	}
+	{{- end }}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return res, &APIError{StatusCode: resp.StatusCode, Body: data, Model: model}
+	}
+	return res, nil`
+
+	paramTemplate = `
+	{
+		value := {{ $.RenderSource }}
+	{{- if $.Required }}
+		if value == "" {
+			err = &MissingParameterError{field:  "{{- $.Property.SourceName}}"}
+			return
+		}
+		{{(($.Property.Reference.RenderExtraction $.Property.Name "value" $.Property.SourceName))}}
+	{{- else }}
+		if value != "" {
+			{{(($.Property.Reference.RenderExtraction $.Property.Name "value" $.Property.SourceName))}}
+		}
+	{{- end }}
+	}
+`
+	bodyExtractTemplate = `
+	{{- if $.OtherContentTypes }}
+	// Only JSON bodies are decoded, the body of other content types is left zero.
+	if !isContentTypeAllowed(r.Header.Get("Content-Type"), {{ printf "%#v" $.OtherContentTypes }}) {
+	{{- end }}
+	if err = json.NewDecoder(r.Body).Decode(&body); err == io.EOF {
+	{{- if $.Required }}
+		err = &MissingParameterError{field: "body"}
+		return
+	{{- else }}
+		err = nil
+	{{- end }}
+	} else if err != nil {
+		err = &InvalidParameterTypeError{
+			field:    "body",
+			original: err,
+		}
+		return
+	}
+	{{- if $.OtherContentTypes }}
+	}
+	{{- end }}
+`
+	extractParamsTemplate = `
+// extract{{$.Name}}Params extracts parameters of {{$.Name}} from the request.
+func extract{{$.Name}}Params(r *http.Request, pathParams map[string]string) (
+	{{- range $p := $.Input }}
+		{{- $p.ArgName }} {{ $p.Property.Reference.RenderName false }}, 
+	{{- end }} err error) {
+	{{- range $p := $.Input }}
+	{{- if eq $p.In "body" }}
+	{{ $.RenderBodyExtraction $p }}
+	{{- else }}
+	{{ $p.RenderExtraction }}
+	{{- end }}
+	{{- end }}
+	return
+}
+`
+	validateRequestTemplate = `
+// validate{{$.Name}}Request returns all violations of the {{$.Name}} request.
+func validate{{$.Name}}Request(r *http.Request, pathParams map[string]string) (errs []error) {
+	{{- range $p := $.Input }}
+	{{- if ne $p.In "body" }}
+	if err := func() (err error) {
+		var {{ $p.ArgName }} {{ $p.Property.Reference.RenderName false }}
+		{{ $p.RenderExtraction }}
+		_ = {{ $p.ArgName }}
+		return
+	}(); err != nil {
+		errs = append(errs, err)
+	}
+	{{- end }}
+	{{- end }}
+	{{- if $.ContentTypes }}
+	{
+		data, err := ioutil.ReadAll(r.Body)
+		r.Body = ioutil.NopCloser(bytes.NewReader(data))
+		switch {
+		case err != nil:
+			errs = append(errs, &InvalidParameterTypeError{field: "body", original: err})
+		case len(data) == 0:
+		{{- if $.BodyRequired }}
+			errs = append(errs, &MissingParameterError{field: "body"})
+		{{- end }}
+		case !isContentTypeAllowed(r.Header.Get("Content-Type"), {{ printf "%#v" $.ContentTypes }}):
+			errs = append(errs, &InvalidParameterTypeError{
+				field:    "Content-Type",
+				original: fmt.Errorf("content type %q is not allowed", r.Header.Get("Content-Type")),
+			})
+		{{- with $.GetBody }}
+		{{- if $.OtherContentTypes }}
+		case isContentTypeAllowed(r.Header.Get("Content-Type"), {{ printf "%#v" $.OtherContentTypes }}):
+			// Only JSON bodies are decoded.
+		{{- end }}
+		default:
+			var body {{ .Property.Reference.RenderName false }}
+			if err := json.Unmarshal(data, &body); err != nil {
+				errs = append(errs, &InvalidParameterTypeError{field: "body", original: err})
+			}
+		{{- end }}
+		}
+	}
+	{{- end }}
+	return
+}
+`
+	handlerTemplate = `
+func (h *Handler) handle{{$.Name}}(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	{{ range $p := $.Input }}{{ $p.ArgName }}, {{ end }}err := extract{{$.Name}}Params(r, pathParams)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.Server.{{$.Name}}(r.Context(){{ range $p := $.Input }}, {{ $p.ArgName }}{{ end }})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if res == nil {
+		res = &{{$.Name}}Response{}
+	}
+
+	switch {
+	{{- range $o := $.Output }}
+	{{- if $o.Property.Reference }}
+	case res.{{ $o.ResponseField }} != nil:
+		writeJSON(w, {{ $o.RenderStatus }}, res.{{ $o.ResponseField }})
+	{{- end }}
+	{{- end }}
+	default:
+		w.WriteHeader(statusCode(res.StatusCode, {{ $.RenderEmptyStatus }}))
+	}
+}
+`
+	serverResponseTemplate = `
+	// {{$.Name}}Response is a response of {{$.Name}}. The first set field is written to the client.
+	{{$.Name}}Response struct {
+		{{- range $o := $.Output }}
+		{{- if $o.Property.Reference }}
+		{{ $o.ResponseField }} *{{ $o.Property.Reference.RenderName false }}
+		{{- end }}
+		{{- end }}
+		// StatusCode of the response for the default and range status codes or for the response without body.
+		StatusCode int
+	}
+	`
+	setPathParamTemplate = `
+	{{- if not $.Required }}
+		{{(($.Property.Reference.RenderCheckEmpty $.Property.Name))}}{
+	{{- end -}}
+	"{{"{"}}{{- $.Property.SourceName}}{{"}"}}", {{(($.Property.Reference.RenderToString $.Property.Name))}},
+	{{- if not $.Required }}
+		}
+	{{- end  }}
+`
+	setQueryParamTemplate = `
+	{{- if not $.Required }}
+		{{(($.Property.Reference.RenderCheckEmpty $.Property.Name))}}{ 
+	{{- end -}}
+		q.Set("{{- $.Property.SourceName}}", {{(($.Property.Reference.RenderToString $.Property.Name))}})
+	{{- if not $.Required }}
+		}
+	{{- end  }}
+`
+	setHeaderTemplate = `
+	{{- if not $.Required }}
+		{{(($.Property.Reference.RenderCheckEmpty $.Property.Name))}}{
+	{{- end -}}
+		request.Header.Set("{{- $.Property.SourceName}}", {{(($.Property.Reference.RenderToString $.Property.Name))}})
+	{{- if not $.Required }}
+		}
+	{{- end  }}
+`
+	extractIntTemplate = `
+	{{- if eq $.Type $.ParsedType }}
+	{{$.Name}}, err = strconv.{{$.Parse}}({{$.NameIn}}, 10, {{$.BitSize}})
+	if err != nil {
+		err = &InvalidParameterTypeError{
+			field:"{{$.Field}}",
+			original: err,
+		}
+		return
+	}
+	{{- else }}
+	{
+		var v {{$.ParsedType}}
+		v, err = strconv.{{$.Parse}}({{$.NameIn}}, 10, {{$.BitSize}})
+		if err != nil {
+			err = &InvalidParameterTypeError{
+				field:"{{$.Field}}",
+				original: err,
+			}
+			return
+		}
+		{{$.Name}} = {{$.Type}}(v)
+	}
+	{{- end }}
+`
+	extractFloatTemplate = `
+	{{- if eq $.Type "float64" }}
+	{{$.Name}}, err = strconv.ParseFloat({{$.NameIn}}, 64)
+	if err != nil {
+		err = &InvalidParameterTypeError{
+			field:"{{$.Field}}",
+			original: err,
+		}
+		return
+	}
+	{{- else }}
+	{
+		var v float64
+		v, err = strconv.ParseFloat({{$.NameIn}}, 32)
+		if err != nil {
+			err = &InvalidParameterTypeError{
+				field:"{{$.Field}}",
+				original: err,
+			}
+			return
+		}
+		{{$.Name}} = {{$.Type}}(v)
+	}
+	{{- end }}
+`
+	extractBoolTemplate = `
+	{{$.Name}}, err = strconv.ParseBool({{$.NameIn}})
+	if err != nil {
+		err = &InvalidParameterTypeError{
+			field:"{{$.Field}}",
+			original: err,
+		}
+		return
+	}
+`
+	extractCustomTemplate = `
+	{{- if $.Elem }}
+	{{$.Name}} = new({{$.Elem}})
+	{{- end }}
+	err = {{$.Name}}.UnmarshalText([]byte({{$.NameIn}}))
+	if err != nil {
+		err = &InvalidParameterTypeError{
+			field:"{{$.Field}}",
+			original: err,
+		}
+		return
+	}
+`
+	extractDatetimeTemplate = `
+	{{$.Name}}, err = time.Parse({{$.Layout}}, {{$.NameIn}})
+	if err != nil {
+		err = &InvalidParameterTypeError{
+			field:"{{$.Field}}",
+			original: err,
+		}
+		return
+	}
+`
+	extractSliceTemplate = `
+	for _, v := range strings.Split({{$.NameIn}}, ",") {
+		var item {{$.ItemType}}
+		{{$.ItemExtraction}}
+		{{$.Name}} = append({{$.Name}}, item)
+	}
+`
+	extractJSONTemplate = `
+	if err = json.Unmarshal([]byte({{$.NameIn}}), &{{$.Name}}); err != nil {
+		err = &InvalidParameterTypeError{
+			field:"{{$.Field}}",
+			original: err,
+		}
+		return
+	}
+`
+
+	structValidateTemplate = `
+		{{- if eq $.Name "" -}}
+			if ok, err := govalidator.ValidateStruct(r); !ok {
+				return ok, err
+			}
+			{{- range $p := $.P.Properties }}
+			{{ $.P.RenderChecks $p false }}
+			{{- end }}
+			return true, nil
+		{{- else -}}
+			return r.{{$.Name}}.Validate()
+		{{- end -}}
+	`
+
+	unionTemplate = `
+	{{- $name := $.P.RenderName $.IsAbbreviate -}}
+	{{- if $.IsAbbreviate}}
+		//{{ $.P.Desc }}
+	{{- end }}
+	{{ $name }} struct {
+		Value {{ $name }}Value
+	}
+
+	{{ $name }}Value interface {
+		is{{ $.P.Name }}()
+	}
+	`
+	unionMethodsTemplate = `
+{{- $name := $.P.RenderName $.IsAbbreviate }}
+{{ range $v := $.P.Variants }}
+func ({{ $v.Reference.RenderName $.IsAbbreviate }}) is{{ $.P.Name }}() {}
+{{ end }}
+{{ if $.P.Discriminator -}}
+// MarshalJSON encodes the {{ $name }} variant with its "{{ $.P.Discriminator }}" property.
+func (u {{ $name }}) MarshalJSON() ([]byte, error) {
+	var value string
+	switch u.Value.(type) {
+	{{- range $c := $.P.VariantCases }}
+	{{- $t := $c.Variant.Reference.RenderName $.IsAbbreviate }}
+	case {{ $t }}, *{{ $t }}:
+		value = {{ printf "%q" $c.Value }}
+	{{- end }}
+	}
+	data, err := json.Marshal(u.Value)
+	if err != nil || value == "" {
+		return data, err
+	}
+	return setDiscriminator(data, {{ printf "%q" $.P.Discriminator }}, value)
+}
+{{- else -}}
+// MarshalJSON encodes the {{ $name }} variant.
+func (u {{ $name }}) MarshalJSON() ([]byte, error) {
+	return json.Marshal(u.Value)
+}
+{{- end }}
+
+{{ if $.P.Discriminator -}}
+// UnmarshalJSON decodes the {{ $name }} variant selected by the "{{ $.P.Discriminator }}" property.
+func (u *{{ $name }}) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		u.Value = nil
+		return nil
+	}
+	var d struct {
+		Value string ` + "`" + `json:"{{ $.P.Discriminator }}"` + "`" + `
+	}
+	if err := json.Unmarshal(data, &d); err != nil {
+		return err
+	}
+	switch d.Value {
+	{{- range $c := $.P.Cases }}
+	case "{{ $c.Value }}":
+		v := &{{ $c.Variant.Reference.RenderName $.IsAbbreviate }}{}
+		if err := json.Unmarshal(data, v); err != nil {
+			return err
+		}
+		u.Value = v
+	{{- end }}
+	default:
+		return fmt.Errorf("{{ $name }}: unknown {{ $.P.Discriminator }} %q", d.Value)
+	}
+	return nil
+}
+{{- else -}}
+// UnmarshalJSON decodes the {{ $name }} variant matching the data.
+func (u *{{ $name }}) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		u.Value = nil
+		return nil
+	}
+	var matches []{{ $name }}Value
+	{{- range $v := $.P.Variants }}
+	{
+		v := &{{ $v.Reference.RenderName $.IsAbbreviate }}{}
+		d := json.NewDecoder(bytes.NewReader(data))
+		d.DisallowUnknownFields()
+		if d.Decode(v) == nil {
+			matches = append(matches, v)
+		}
+	}
+	{{- end }}
+	switch {
+	case len(matches) == 0:
+		return fmt.Errorf("{{ $name }}: data does not match any variant")
+	{{- if $.P.Exclusive }}
+	case len(matches) > 1:
+		return fmt.Errorf("{{ $name }}: data matches %d variants", len(matches))
+	{{- end }}
+	}
+	u.Value = matches[0]
+	return nil
+}
+{{- end }}
+`
+	nativeValidateTemplate = `
+	{{- range $p := $.P.Properties }}
+	{{ $.P.RenderChecks $p true }}
+	{{- end }}`
+	nativeUnionValidateTemplate = `if v, ok := r.Value.(interface{ validate(string, *ValidationErrors) }); ok {
+		v.validate(path, errs)
+	}`
+	unionValidateTemplate = `if v, ok := r.Value.(interface{ Validate() (bool, error) }); ok {
+		return v.Validate()
+	}
+	return true, nil`
+)
+
+func renderTemplate(tname, t string, i interface{}) string {
+	buf := bytes.NewBuffer([]byte{})
+
+	tmpl, err := template.New(tname).Funcs(template.FuncMap{
+		"inc": func(i int) int {
+			return i + 1
+		},
+	}).Parse(t)
+	// Templates are constants, so their errors are bugs of the generator.
+	// Panics of methods called by the executing template are returned by it as errors.
+	if err != nil {
+		panic(err)
+	}
+
+	err = tmpl.Execute(buf, i)
+	if err != nil {
+		panic(err)
+	}
+
+	return buf.String()
+}
+
+// NewContext builds Context with References for all components and Functions for all operations of the Swagger.
+func NewContext(s *spec.Swagger, o Options) (Context, error) {
+	if err := o.Validate(); err != nil {
+		return Context{}, err
+	}
+	c := Context{
+		PackageName:  o.PackageName,
+		Info:         s.Info,
+		IsAbbreviate: o.IsAbbreviate,
+		Validator:    o.Validator,
+		References:   make(map[string]Property),
+		Functions:    []Function{},
+		building:     make(map[string]bool),
+		lenientEnums: o.LenientEnums,
+		typeMap:      o.TypeMap,
+		imports:      make(map[string]string),
+		swagger:      s,
+		tags:         o.Tags,
+		operations:   o.Operations,
+		keepOrder:    o.KeepOrder,
+		names:        make(map[string]string),
+		owners:       make(map[string]string),
+	}
+	c.optionalStrategy = o.Optional
+	if c.optionalStrategy == "" {
+		c.optionalStrategy = OptionalValue
+	}
+
+	c.nameReferences(s)
+
+	// Maps are iterated in the order of keys, so the same specification always builds the same Context.
+	for _, n := range spec.SortedKeys(s.Components.Schemas) {
+		c.setProperty(s.Components.Schemas[n], n, "", "", "")
+	}
+	for _, n := range spec.SortedKeys(s.Components.RequestBodies) {
+		rb := s.Components.RequestBodies[n]
+		for _, k := range spec.SortedKeys(rb.Content) {
+			if rb.Check(k) {
+				c.setProperty(rb.Content[k].Schema, n, "", "", "")
+			}
+		}
+	}
+	for _, n := range spec.SortedKeys(s.Components.Responses) {
+		response := s.Components.Responses[n]
+		for _, k := range spec.SortedKeys(response.Content) {
+			if response.Check(k) {
+				c.setProperty(response.Content[k].Schema, n, "", "", "")
+			}
+		}
+	}
+
+	paths := spec.SortedKeys(s.Paths)
+	if c.keepOrder {
+		paths = s.PathNames()
+	}
+	for _, path := range paths {
+		c.setFunctions(path, s.Paths[path])
+	}
+	if len(c.diagnostics) > 0 {
+		return c, c.diagnostics.Compact()
+	}
+	return c, nil
+}
+
+// fail records the diagnostic of the node of the specification. Building of the Context continues.
+func (ctx *Context) fail(node interface{}, format string, args ...interface{}) {
+	ctx.diagnostics = append(ctx.diagnostics, spec.Diagnostic{
+		Location: ctx.swagger.Locate(node),
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// SortedFunctions returns functions sorted by names or in the declaration order of operations if it is kept.
+func (c Context) SortedFunctions() []Function {
+	if !c.keepOrder {
+		sort.Stable(functions(c.Functions))
+	}
+	return c.Functions
+}
+
+// SortedReferences returns references sorted by literals, references with the same literal are ordered by their keys.
+func (c Context) SortedReferences() []Property {
+	arr := []Property{}
+	for _, k := range spec.SortedKeys(c.References) {
+		arr = append(arr, c.References[k])
+	}
+	sort.Stable(propertiesByLiteral(arr))
+	return arr
+}
+
+// SortedProperties returns properties sorted by names or in the declaration order if the Struct is Ordered.
+func (c Struct) SortedProperties() []Property {
+	if !c.Ordered {
+		sort.Stable(propertiesByName(c.Properties))
+	}
+	return c.Properties
+}
+
+func (f *Function) RenderSignature() string {
+	return renderTemplate("signature", signatureTemplate, f)
+}
+
+// RenderFuncType renders type of the function with the signature e.g.: func(ctx context.Context) (*GetPetResponse, error)
+func (f *Function) RenderFuncType() string {
+	return "func" + strings.TrimPrefix(f.RenderSignature(), f.Name)
+}
+
+func (f *Function) RenderResponse() string {
+	return renderTemplate("response", responseTemplate, f)
+}
+
+func (f *Function) RenderServerResponse() string {
+	return renderTemplate("serverResponse", serverResponseTemplate, f)
+}
+
+func (f *Function) RenderExtractParams() string {
+	return renderTemplate("extractParams", extractParamsTemplate, f)
+}
+
+func (f *Function) RenderValidateRequest() string {
+	return renderTemplate("validateRequest", validateRequestTemplate, f)
+}
+
+func (f *Function) RenderHandler() string {
+	return renderTemplate("handler", handlerTemplate, f)
+}
+
+// RenderRoute renders the path template split into segments e.g.: []string{"pets", "{petId}"}
+func (f *Function) RenderRoute() string {
+	return fmt.Sprintf("%#v", f.Segments())
+}
+
+// Segments returns the path template split into segments.
+func (f *Function) Segments() []string {
+	return strings.Split(strings.Trim(f.Path, "/"), "/")
+}
+
+// RenderEmptyStatus renders the status code of the response without body.
+func (f *Function) RenderEmptyStatus() string {
+	for _, el := range f.Output {
+		if _, err := strconv.Atoi(el.In); err == nil && el.Property.Reference == nil {
+			return el.In
+		}
+	}
+	return "http.StatusOK"
+}
+
+func newParam(in string, required bool, p Property) Param {
+	p.Name = ToCamelCase(false, p.Name)
+	return Param{in, required, p}
+}
+
+// reservedNames are identifiers used by the generated functions which can't be names of their arguments:
+// the context, receivers, the body argument and local variables of the templates.
+var reservedNames = map[string]bool{
+	"ctx": true, "c": true, "h": true, "m": true, "r": true, "w": true, "body": true, "pathParams": true,
+	"u": true, "q": true, "bs": true, "err": true, "errs": true, "request": true, "resp": true, "data": true,
+	"res": true, "model": true, "dest": true, "value": true, "v": true, "wv": true, "ev": true, "item": true,
+}
+
+// argName returns the name of the parameter argument. Names colliding with reserved names, Go keywords,
+// predeclared identifiers, imported packages or names of other arguments get the Param suffix e.g.: ctxParam.
+func (ctx *Context) argName(name string, used map[string]bool) string {
+	if name == "" || !unicode.IsLetter(rune(name[0])) {
+		name = "param" + name
+	}
+	collides := func(n string) bool {
+		if reservedNames[n] || used[n] || token.IsKeyword(n) || types.Universe.Lookup(n) != nil || knownImports[n] != "" {
+			return true
+		}
+		for _, pkg := range ctx.imports {
+			if n == pkg {
+				return true
+			}
+		}
+		return false
+	}
+	n := name
+	for i := 1; collides(n); i++ {
+		n = name + "Param"
+		if i > 1 {
+			n += strconv.Itoa(i)
+		}
+	}
+	used[n] = true
+	return n
+}
+
+func (f *Function) RenderBody() string {
+	return renderTemplate("funcBody", funcBodyTemplate, f)
+}
+
+func (f *Function) RenderPathParams() string {
+	return renderTemplate("pathParams", pathParamsTemplate, f)
+}
+
+func (f *Function) RenderQueryParams() string {
+	return renderTemplate("queryParams", queryParamsTemplate, f)
+}
+
+func (f *Function) RenderRequestBody() string {
+	return renderTemplate("requestBody", requestBodyTemplate, f)
+}
+
+func (f *Function) GetBody() *Param {
+	for _, el := range f.Input {
+		if el.In == "body" {
+			return &el
+		}
+	}
+	return nil
+}
+
+func (f *Function) HasPathParam() bool {
+	for _, el := range f.Input {
+		if el.In == "path" {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *Function) GetPathParams() (params []Param) {
+	return f.getParamsByIn("path")
+}
+
+func (f *Function) GetQueryParams() (params []Param) {
+	return f.getParamsByIn("query")
+}
+
+func (f *Function) GetHeaders() (params []Param) {
+	return f.getParamsByIn("header")
+}
+
+func (f *Function) getParamsByIn(in string) (params []Param) {
+	params = make([]Param, 0)
+	for _, el := range f.Input {
+		if el.In == in {
+			params = append(params, el)
+		}
+	}
+	return
+}
+
+func (p *Param) RenderPathParam() string {
+	return renderTemplate("pParam", setPathParamTemplate, p)
+}
+
+func (p *Param) RenderQueryParam() string {
+	return renderTemplate("qParam", setQueryParamTemplate, p)
+}
+
+func (p *Param) RenderHeader() string {
+	return renderTemplate("headerParam", setHeaderTemplate, p)
+}
+
+// ArgName returns name of the function argument for the Param.
+func (p *Param) ArgName() string {
+	if p.In == "body" {
+		return "body"
+	}
+	return p.Property.Name
+}
+
+// RenderSource renders expression of the raw parameter value in the request.
+func (p *Param) RenderSource() string {
+	switch p.In {
+	case "path":
+		return fmt.Sprintf("pathParams[%q]", p.Property.SourceName)
+	case "header":
+		return fmt.Sprintf("r.Header.Get(%q)", p.Property.SourceName)
+	case "cookie":
+		return fmt.Sprintf("cookieValue(r, %q)", p.Property.SourceName)
+	}
+	return fmt.Sprintf("r.URL.Query().Get(%q)", p.Property.SourceName)
+}
+
+// RenderBodyExtraction renders decoding of the JSON body Param of the Function.
+func (f *Function) RenderBodyExtraction(p Param) string {
+	return renderTemplate(
+		"bodyExtract", bodyExtractTemplate,
+		struct {
+			Required          bool
+			OtherContentTypes []string
+		}{p.Required, f.OtherContentTypes})
+}
+
+// RenderStatus renders the status code written for the response.
+func (p *Param) RenderStatus() string {
+	switch {
+	case p.In == "default":
+		return "statusCode(res.StatusCode, http.StatusInternalServerError)"
+	case isStatusRange(p.In):
+		return fmt.Sprintf("statusCode(res.StatusCode, %s00)", p.In[:1])
+	}
+	return p.In
+}
+
+// ResponseField returns name of the response struct field for the status code.
+func (p *Param) ResponseField() string {
+	if p.In == "default" {
+		return "Default"
+	}
+	return "JSON" + strings.ToUpper(p.In)
+}
+
+// RenderResponseCase renders switch case matching the status code of the response.
+func (p *Param) RenderResponseCase() string {
+	switch {
+	case p.In == "default":
+		return "default"
+	case isStatusRange(p.In):
+		return fmt.Sprintf("case resp.StatusCode/100 == %s", p.In[:1])
+	}
+	return fmt.Sprintf("case resp.StatusCode == %s", p.In)
+}
+
+func (s *String) RenderLiteral() string                     { return "string" }
+func (s *String) RenderName(isAbbreviate bool) string       { return "string" }
+func (s *String) RenderDefinition(isAbbreviate bool) string { return "" }
+func (s *String) RenderExtraction(to, that, field string) string {
+	return fmt.Sprintf("%s = %s", to, that)
+}
+func (s *String) RenderFormat() string   { return "" }
+func (s *String) RenderValues() []string { return s.Values }
+func (s *String) RenderDefault() string  { return s.Default }
+func (s *String) RenderCheckEmpty(name string) string {
+	return fmt.Sprintf("if %s != \"\" ", name)
+}
+func (s *String) RenderToString(name string) string {
+	return name
+}
+func (s *String) RenderMethods(isAbbreviate bool) string { return "" }
+
+func (dt *Datetime) RenderLiteral() string                     { return "time.Time" }
+func (dt *Datetime) RenderName(isAbbreviate bool) string       { return "time.Time" }
+func (dt *Datetime) RenderDefinition(isAbbreviate bool) string { return "" }
+func (dt *Datetime) RenderExtraction(to, that, field string) string {
+	return renderTemplate(
+		"datetime", extractDatetimeTemplate,
+		struct {
+			Name   string
+			NameIn string
+			Field  string
+			Layout string
+		}{to, that, field, dt.layout()})
+}
+func (dt *Datetime) RenderFormat() string { return dt.Format }
+func (dt *Datetime) RenderCheckEmpty(name string) string {
+	return fmt.Sprintf("if !%s.IsZero()", name)
+}
+func (dt *Datetime) RenderToString(name string) string {
+	return fmt.Sprintf("%s.Format(%s)", name, dt.layout())
+}
+
+// layout returns Go expression of the time layout for the Format.
+func (dt *Datetime) layout() string {
+	if dt.Format == "date" {
+		return `"2006-01-02"`
+	}
+	return "time.RFC3339"
+}
+func (dt *Datetime) RenderMethods(isAbbreviate bool) string { return "" }
+
+func (i *Integer) RenderLiteral() string {
+	if t, ok := integerTypes[i.Format]; ok {
+		return t.Type
+	}
+	return "int64"
+}
+func (i *Integer) RenderName(isAbbreviate bool) string       { return i.RenderLiteral() }
+func (i *Integer) RenderDefinition(isAbbreviate bool) string { return "" }
+func (i *Integer) RenderExtraction(to, that, field string) string {
+	parse, parsedType, bitSize := i.parser()
+	return renderTemplate(
+		"int", extractIntTemplate,
+		struct {
+			Name       string
+			NameIn     string
+			Field      string
+			Type       string
+			ParsedType string
+			Parse      string
+			BitSize    int
+		}{to, that, field, i.RenderLiteral(), parsedType, parse, bitSize})
+}
+func (i *Integer) RenderFormat() string { return "" }
+func (i *Integer) RenderCheckEmpty(name string) string {
+	return fmt.Sprintf("if %s != 0 ", name)
+}
+func (i *Integer) RenderToString(name string) string {
+	switch t := i.RenderLiteral(); {
+	case t == "int64":
+		return fmt.Sprintf("strconv.FormatInt(%s, 10)", name)
+	case t == "uint64":
+		return fmt.Sprintf("strconv.FormatUint(%s, 10)", name)
+	case i.isUnsigned():
+		return fmt.Sprintf("strconv.FormatUint(uint64(%s), 10)", name)
+	}
+	return fmt.Sprintf("strconv.FormatInt(int64(%s), 10)", name)
+}
+
+// parser returns the strconv function parsing the integer, the type it returns and the bit size of the integer.
+func (i *Integer) parser() (string, string, int) {
+	parse, parsedType, bitSize := "ParseInt", "int64", 64
+	if t, ok := integerTypes[i.Format]; ok {
+		bitSize = t.BitSize
+	}
+	if i.isUnsigned() {
+		parse, parsedType = "ParseUint", "uint64"
+	}
+	return parse, parsedType, bitSize
+}
+func (i *Integer) isUnsigned() bool {
+	return strings.HasPrefix(i.RenderLiteral(), "uint")
+}
+func (i *Integer) RenderMethods(isAbbreviate bool) string { return "" }
+
+func (n *Number) RenderLiteral() string {
+	if n.Format == "float" {
+		return "float32"
+	}
+	return "float64"
+}
+func (n *Number) RenderName(isAbbreviate bool) string       { return n.RenderLiteral() }
+func (n *Number) RenderDefinition(isAbbreviate bool) string { return "" }
+func (n *Number) RenderExtraction(to, that, field string) string {
+	return renderTemplate(
+		"float", extractFloatTemplate,
+		struct {
+			Name   string
+			NameIn string
+			Field  string
+			Type   string
+		}{to, that, field, n.RenderLiteral()})
+}
+func (n *Number) RenderFormat() string { return "" }
+func (n *Number) RenderCheckEmpty(name string) string {
+	return fmt.Sprintf("if %s != 0 ", name)
+}
+func (n *Number) RenderToString(name string) string {
+	if n.RenderLiteral() == "float32" {
+		return fmt.Sprintf("strconv.FormatFloat(float64(%s), 'f', -1, 32)", name)
+	}
+	return fmt.Sprintf("strconv.FormatFloat(%s, 'f', -1, 64)", name)
+}
+func (n *Number) RenderMethods(isAbbreviate bool) string { return "" }
+
+func (b *Bool) RenderLiteral() string                     { return "bool" }
+func (b *Bool) RenderName(isAbbreviate bool) string       { return "bool" }
+func (b *Bool) RenderDefinition(isAbbreviate bool) string { return "" }
+func (b *Bool) RenderExtraction(to, that, field string) string {
+	return renderTemplate(
+		"bool", extractBoolTemplate,
+		struct {
+			Name   string
+			NameIn string
+			Field  string
+		}{to, that, field})
+}
+func (b *Bool) RenderFormat() string { return "" }
+func (b *Bool) RenderCheckEmpty(name string) string {
+	return "if true "
+}
+func (b *Bool) RenderToString(name string) string {
+	return fmt.Sprintf("strconv.FormatBool(%s)", name)
+}
+func (b *Bool) RenderMethods(isAbbreviate bool) string { return "" }
+
+func (c *Custom) RenderLiteral() string                     { return c.Type }
+func (c *Custom) RenderName(isAbbreviate bool) string       { return c.Type }
+func (c *Custom) RenderDefinition(isAbbreviate bool) string { return "" }
+func (c *Custom) RenderExtraction(to, that, field string) string {
+	elem := ""
+	if strings.HasPrefix(c.Type, "*") {
+		elem = c.Type[1:]
+	}
+	return renderTemplate(
+		"custom", extractCustomTemplate,
+		struct {
+			Name   string
+			NameIn string
+			Field  string
+			// Elem is allocated before decoding into the pointer type.
+			Elem string
+		}{to, that, field, elem})
+}
+func (c *Custom) RenderFormat() string { return "" }
+func (c *Custom) RenderCheckEmpty(name string) string {
+	return "if true "
+}
+func (c *Custom) RenderToString(name string) string {
+	return fmt.Sprintf("fmt.Sprint(%s)", name)
+}
+func (c *Custom) RenderMethods(isAbbreviate bool) string { return "" }
+
+func (p *Pointer) RenderLiteral() string { return "*" + p.Reference.RenderLiteral() }
+func (p *Pointer) RenderName(isAbbreviate bool) string {
+	return "*" + p.Reference.RenderName(isAbbreviate)
+}
+func (p *Pointer) RenderDefinition(isAbbreviate bool) string { return "" }
+func (p *Pointer) RenderExtraction(to, that, field string) string {
+	return renderTemplate(
+		"pointerExtract", extractWrappedTemplate,
+		struct{ Name, Type, Extraction, Wrap string }{
+			to, p.Reference.RenderName(false), p.Reference.RenderExtraction("wv", that, field), "&wv",
+		})
+}
+func (p *Pointer) RenderFormat() string { return p.Reference.RenderFormat() }
+func (p *Pointer) RenderCheckEmpty(name string) string {
+	return fmt.Sprintf("if %s != nil ", name)
+}
+func (p *Pointer) RenderToString(name string) string {
+	return p.Reference.RenderToString("(*" + name + ")")
+}
+func (p *Pointer) RenderMethods(isAbbreviate bool) string { return "" }
+
+func (g *Generic) RenderLiteral() string {
+	return fmt.Sprintf("%s[%s]", g.Name, g.Reference.RenderLiteral())
+}
+func (g *Generic) RenderName(isAbbreviate bool) string {
+	return fmt.Sprintf("%s[%s]", g.Name, g.Reference.RenderName(isAbbreviate))
+}
+func (g *Generic) RenderDefinition(isAbbreviate bool) string { return "" }
+func (g *Generic) RenderExtraction(to, that, field string) string {
+	return renderTemplate(
+		"genericExtract", extractWrappedTemplate,
+		struct{ Name, Type, Extraction, Wrap string }{
+			to, g.Reference.RenderName(false), g.Reference.RenderExtraction("wv", that, field),
+			fmt.Sprintf("%s{Value: wv, Set: true}", g.RenderName(false)),
+		})
+}
+func (g *Generic) RenderFormat() string { return g.Reference.RenderFormat() }
+func (g *Generic) RenderCheckEmpty(name string) string {
+	return fmt.Sprintf("if %s ", g.presenceCond(name))
+}
+func (g *Generic) RenderToString(name string) string {
+	return g.Reference.RenderToString(name + ".Value")
+}
+func (g *Generic) RenderMethods(isAbbreviate bool) string { return "" }
+
+// presenceCond returns condition of the value being set and not null.
+func (g *Generic) presenceCond(name string) string {
+	if g.Name == "Nullable" {
+		return fmt.Sprintf("%s.Set && !%s.Null", name, name)
+	}
+	return name + ".Set"
+}
+
+func (s *Slice) RenderLiteral() string { return s.Name }
+func (s *Slice) RenderName(isAbbreviate bool) string {
+	return "[]" + s.ItemsType.Reference.RenderName(isAbbreviate)
+}
+func (s *Slice) RenderDefinition(isAbbreviate bool) string {
+	return renderTemplate("slice", arrayTemplate, s)
+}
+func (s *Slice) RenderExtraction(to, that, field string) string {
+	return renderTemplate(
+		"sliceExtract", extractSliceTemplate,
+		struct {
+			Name           string
+			NameIn         string
+			ItemType       string
+			ItemExtraction string
+		}{to, that, s.ItemsType.Reference.RenderName(false), s.ItemsType.Reference.RenderExtraction("item", "v", field)})
+}
+func (s *Slice) RenderFormat() string { return "" }
+func (s *Slice) RenderCheckEmpty(name string) string {
+	return ""
+}
+func (s *Slice) RenderToString(name string) string {
+	return ""
+}
+func (s *Slice) RenderMethods(isAbbreviate bool) string { return "" }
+
+func (s *Dictionary) RenderLiteral() string { return s.Name }
+func (s *Dictionary) RenderName(isAbbreviate bool) string {
+	return "map[string]" + s.ItemsType.Reference.RenderName(isAbbreviate)
+}
+func (s *Dictionary) RenderDefinition(isAbbreviate bool) string {
+	return renderTemplate("dict", dictTemplate, s)
+}
+func (s *Dictionary) RenderExtraction(to, that, field string) string {
+	return renderJSONExtraction(to, that, field)
+}
+func (s *Dictionary) RenderFormat() string { return "" }
+func (s *Dictionary) RenderCheckEmpty(name string) string {
+	return ""
+}
+func (s *Dictionary) RenderToString(name string) string {
+	return ""
+}
+func (s *Dictionary) RenderMethods(isAbbreviate bool) string { return "" }
+
+func (e *Enum) RenderLiteral() string { return e.Name }
+func (e *Enum) RenderName(isAbbreviate bool) string {
+	if isAbbreviate {
+		return e.AbbrName
+	}
+	return e.Name
+}
+func (e *Enum) RenderDefinition(isAbbreviate bool) string {
+	return renderTemplate(
+		"enum", enumTemplate,
+		struct {
+			IsAbbreviate bool
+			P            *Enum
+		}{isAbbreviate, e})
+}
+func (e *Enum) RenderExtraction(to, that, field string) string {
+	var extraction string
+	if e.Base == "string" {
+		extraction = fmt.Sprintf("ev = %s", that)
+	} else {
+		extraction = (&Integer{Format: e.Format}).RenderExtraction("ev", that, field)
+	}
+	return renderTemplate(
+		"enumExtract", extractEnumTemplate,
+		struct {
+			Name       string
+			Field      string
+			Base       string
+			Type       string
+			Extraction string
+			Lenient    bool
+		}{to, field, e.Base, e.Name, extraction, e.Lenient})
+}
+
+// RenderParse renders parsing of the string expression into the base integer of the enum.
+func (e *Enum) RenderParse(that string) string {
+	parse, _, bitSize := (&Integer{Format: e.Format}).parser()
+	return fmt.Sprintf("strconv.%s(%s, 10, %d)", parse, that, bitSize)
+}
+func (e *Enum) RenderFormat() string { return "" }
+func (e *Enum) RenderCheckEmpty(name string) string {
+	if e.Base == "string" {
+		return fmt.Sprintf("if %s != \"\" ", name)
+	}
+	return fmt.Sprintf("if %s != 0 ", name)
+}
+func (e *Enum) RenderToString(name string) string {
+	if e.Base == "string" {
+		return fmt.Sprintf("string(%s)", name)
+	}
+	return (&Integer{Format: e.Format}).RenderToString(fmt.Sprintf("%s(%s)", e.Base, name))
+}
+func (e *Enum) RenderMethods(isAbbreviate bool) string {
+	return renderTemplate(
+		"enumMethods", enumMethodsTemplate,
+		struct {
+			IsAbbreviate bool
+			P            *Enum
+		}{isAbbreviate, e})
+}
+func (e *Enum) RenderValidate(name string) string {
+	return renderTemplate("enumValidate", enumValidateTemplate, struct{ Values string }{strings.Join(e.Values, ", ")})
+}
+func (e *Enum) RenderNativeValidate() string {
+	return renderTemplate("nativeEnumValidate", nativeEnumValidateTemplate, struct{ Values string }{strings.Join(e.Values, ", ")})
+}
+
+// Constants returns constants of the Enum values e.g.: PetStatusAvailable = "available".
+// Negative values get the Minus prefix e.g.: LevelMinus1, names colliding with previous ones get the index of the value.
+func (e *Enum) Constants(isAbbreviate bool) []EnumConstant {
+	name := e.RenderName(isAbbreviate)
+	cs := make([]EnumConstant, len(e.Values))
+	seen := map[string]bool{}
+	for i, v := range e.Values {
+		suffix := ToCamelCase(true, v)
+		if suffix == "" {
+			suffix = "Empty"
+		}
+		value := v
+		if e.Base == "string" {
+			value = fmt.Sprintf("%q", v)
+		} else if strings.HasPrefix(v, "-") {
+			suffix = "Minus" + suffix
+		}
+		c := name + suffix
+		for n := i; seen[c]; n++ {
+			c = name + suffix + strconv.Itoa(n)
+		}
+		seen[c] = true
+		cs[i] = EnumConstant{c, value}
+	}
+	return cs
+}
+
+func (s *Struct) RenderLiteral() string { return s.Name }
+func (s *Struct) RenderName(isAbbreviate bool) string {
+	if isAbbreviate {
+		return s.AbbrName
+	}
+	return s.Name
+}
+func (s *Struct) RenderDefinition(isAbbreviate bool) string {
+	return renderTemplate(
+		"struct",
+		structTemplate,
+		struct {
+			IsAbbreviate bool
+			P            *Struct
+		}{isAbbreviate, s})
+}
+func (s *Struct) RenderExtraction(to, that, field string) string {
+	return renderJSONExtraction(to, that, field)
+}
+
+func (s *Struct) RenderValidate(name string) string {
+	return renderTemplate(
+		"structValidate",
+		structValidateTemplate,
+		struct {
+			Name string
+			P    *Struct
+		}{name, s})
+}
+
+// RenderNativeValidate renders the body of the validate method collecting all violations of the struct.
+func (s *Struct) RenderNativeValidate() string {
+	return renderTemplate("nativeValidate", nativeValidateTemplate, struct{ P *Struct }{s})
+}
+func (s *Struct) RenderFormat() string { return "" }
+
+// RenderChecks renders checks of the validation keywords of the struct field and validation of its nested values.
+// Native checks collect violations with paths of fields instead of returning the first one.
+func (s *Struct) RenderChecks(p Property, native bool) string {
+	name := "r." + p.Name
+	field := fmt.Sprintf("%q", p.SourceName)
+	if native {
+		field = fmt.Sprintf("path + %q", "."+p.SourceName)
+	}
+	c := checker{native}
+	checks := c.checks(p, name, field, s.Name+p.Name, 0)
+	// The nil pointer of the nullable value may be either missing or null, so only the generic one is checked.
+	_, isGeneric := p.Reference.(*Generic)
+	if native && p.Required && (!p.Nullable || isGeneric) {
+		cond := emptyCond(p.Reference, name)
+		if cond == "" {
+			return checks
+		}
+		required := c.render([]constraint{{cond, "is required"}}, field, "")
+		if checks == "" {
+			return required
+		}
+		// Other constraints are meaningless for the missing value.
+		return fmt.Sprintf("%s else {%s\n}", required, checks)
+	}
+	if p.Required || checks == "" {
+		return checks
+	}
+	// Absent optional field is not validated.
+	if cond := presenceCond(p.Reference, name); cond != "" {
+		return fmt.Sprintf("if %s {%s\n}", cond, checks)
+	}
+	return checks
+}
+
+func (s *Struct) RenderTags(p Property) string {
+	tags := []string{buildJsonTag(p), buildValidTag(p), buildExtensionTags(p)}
+	return fmt.Sprintf("`%s`", strings.Trim(strings.Join(tags, " "), " "))
+}
+func (s *Struct) RenderCheckEmpty(name string) string {
+	return ""
+}
+func (s *Struct) RenderToString(name string) string {
+	return ""
+}
+func (s *Struct) RenderMethods(isAbbreviate bool) string { return "" }
+
+// VariantCases returns the first case of every variant, the value of the case is written by MarshalJSON.
+func (u *Union) VariantCases() []UnionCase {
+	cases := []UnionCase{}
+	seen := map[string]bool{}
+	for _, c := range u.Cases {
+		if name := c.Variant.Reference.RenderLiteral(); !seen[name] {
+			seen[name] = true
+			cases = append(cases, c)
+		}
+	}
+	return cases
+}
+
+func (u *Union) RenderLiteral() string { return u.Name }
+func (u *Union) RenderName(isAbbreviate bool) string {
+	if isAbbreviate {
+		return u.AbbrName
+	}
+	return u.Name
+}
+func (u *Union) RenderDefinition(isAbbreviate bool) string {
+	return renderTemplate(
+		"union",
+		unionTemplate,
+		struct {
+			IsAbbreviate bool
+			P            *Union
+		}{isAbbreviate, u})
+}
+func (u *Union) RenderExtraction(to, that, field string) string {
+	return renderJSONExtraction(to, that, field)
+}
+func (u *Union) RenderValidate(name string) string {
+	return renderTemplate("unionValidate", unionValidateTemplate, u)
+}
+func (u *Union) RenderNativeValidate() string {
+	return renderTemplate("nativeUnionValidate", nativeUnionValidateTemplate, u)
+}
+func (u *Union) RenderFormat() string { return "" }
+func (u *Union) RenderCheckEmpty(name string) string {
+	return fmt.Sprintf("if %s.Value != nil ", name)
+}
+func (u *Union) RenderToString(name string) string {
+	return ""
+}
+func (u *Union) RenderMethods(isAbbreviate bool) string {
+	return renderTemplate(
+		"unionMethods",
+		unionMethodsTemplate,
+		struct {
+			IsAbbreviate bool
+			P            *Union
+		}{isAbbreviate, u})
+}
+
+// renderJSONExtraction renders decoding of a parameter serialized as JSON.
+func renderJSONExtraction(to, that, field string) string {
+	return renderTemplate(
+		"jsonExtract", extractJSONTemplate,
+		struct {
+			Name   string
+			NameIn string
+			Field  string
+		}{to, that, field})
+}
+
+func (p *Param) RenderExtraction() string {
+	return renderTemplate("paramExtract", paramTemplate, p)
+}
+
+func (ctx *Context) setProperty(schema *spec.Schema, name, pname, rname, descPname string) Property {
+
+	var refName, desc string
+
+	if rname != "" {
+		refName = rname
+		desc = rname
+	} else {
+		refName = ToCamelCase(true, pname, name)
+		if descPname == "" {
+			desc = refName
+		} else {
+			desc = fmt.Sprintf("%s.%s", descPname, ToCamelCase(true, name))
+		}
+	}
+
+	p := Property{
+		Name:          ToCamelCase(true, name),
+		SourceName:    name,
+		Enum:          schema.Enum,
+		ExtensionTags: schema.ExtensionTags,
+		Validation:    schema.Validation,
+		Nullable:      schema.Nullable,
+	}
+
+	// Referenced and top level types are built once, that also stops recursion on recursive schemas.
+	if r, ok := ctx.References[refName]; ok && (rname != "" || pname == "") {
+		ctx.claim(refName, schema)
+		p.Reference = r.Reference
+		return p
+	}
+
+	if c := ctx.goType(schema); c != nil {
+		p.Reference = c
+		p.Validation = spec.Validation{}
+		return p
+	}
+
+	if pattern := schema.Validation.Pattern; pattern != "" {
+		if _, err := regexp.Compile(pattern); err != nil {
+			ctx.fail(schema, "pattern %s is not supported by Go regular expressions: %s", pattern, err)
+		}
+	}
+
+	switch schema.Type {
+	case "object":
+		if len(schema.OneOf) > 0 || len(schema.AnyOf) > 0 {
+			p.Reference = ctx.newUnion(p, schema, refName, desc)
+		} else if schema.AdditionalProperties == nil {
+			ps := &Struct{
+				Name:       refName,
+				Properties: []Property{},
+				AbbrName:   ToAbbreviate(desc),
+				Desc:       desc,
+				Ordered:    ctx.keepOrder,
+			}
+			p.Reference = ps
+			ctx.define(refName, p, schema)
+			ctx.building[refName] = true
+
+			properties, required := schema.MergedProperties()
+			names := spec.SortedKeys(properties)
+			if ctx.keepOrder {
+				names = schema.PropertyNames()
+			}
+			for _, n := range names {
+				s := properties[n]
+				p := ctx.setProperty(s, n, refName, ctx.refName(s, s.Ref), desc)
+				for _, a := range required {
+					if n == a {
+						p.Required = true
+					}
+				}
+				// A struct can't contain itself, so the field of a type being built is a pointer.
+				if s, ok := p.Reference.(*Struct); ok && ctx.building[s.Name] {
+					p.Reference = &Pointer{Reference: s}
+				}
+				p.Reference = ctx.optional(p)
+				ps.Properties = append(ps.Properties, p)
+			}
+			delete(ctx.building, refName)
+		} else {
+			p.Reference = &Dictionary{
+				Name:      refName,
+				ItemsType: ctx.setProperty(schema.AdditionalProperties, "", refName, ctx.refName(schema.AdditionalProperties, schema.AdditionalProperties.Ref), desc),
+			}
+		}
+	case "string", "integer":
+		if len(schema.Enum) > 0 {
+			// Inline enum of items is named after the array.
+			if name == "" && rname == "" {
+				refName, desc = refName+"Item", desc+"Item"
+			}
+			p.Reference = ctx.newEnum(p, schema, refName, desc)
+			break
+		}
+		if schema.Type == "integer" {
+			p.Reference = &Integer{Format: schema.Format}
+			break
+		}
+		switch schema.Format {
+		case "date", "date-time":
+			p.Reference = &Datetime{
+				Format: schema.Format,
+			}
+		default:
+			p.Reference = &String{
+				Default: schema.Default,
+				Values:  schema.Enum,
+				Format:  schema.Format,
+			}
+		}
+	case "array":
+		if schema.Items == nil {
+			ctx.fail(schema, "items of the array are not set")
+			break
+		}
+		p.Reference = &Slice{
+			Name:      ctx.refName(schema.Items, schema.Items.Ref),
+			ItemsType: ctx.setProperty(schema.Items, "", refName, ctx.refName(schema.Items, schema.Items.Ref), desc),
+		}
+	case "number":
+		p.Reference = &Number{Format: schema.Format}
+	case "boolean":
+		p.Reference = &Bool{}
+	default:
+		ctx.fail(schema, "unsupported type %q", schema.Type)
+	}
+	return p
+}
+
+// goType returns the existing Go type of the schema set by x-go-type or mapped by its format, or nil.
+func (ctx *Context) goType(schema *spec.Schema) *Custom {
+	if schema.GoType != "" {
+		if schema.GoTypeImport != "" {
+			ctx.imports[schema.GoTypeImport] = qualifier(schema.GoType)
+		}
+		return &Custom{Type: schema.GoType, Import: schema.GoTypeImport}
+	}
+	if t, ok := ctx.typeMap[schema.Format]; ok && schema.Format != "" {
+		typ, importPath := parseGoType(t)
+		if importPath != "" {
+			ctx.imports[importPath] = qualifier(typ)
+		}
+		return &Custom{Type: typ, Import: importPath}
+	}
+	return nil
+}
+
+// textUnmarshaler is encoding.TextUnmarshaler, custom types of parameters are decoded by it.
+var textUnmarshaler = types.NewInterfaceType([]*types.Func{
+	types.NewFunc(token.NoPos, nil, "UnmarshalText", types.NewSignatureType(nil, nil, nil,
+		types.NewTuple(types.NewVar(token.NoPos, nil, "", types.NewSlice(types.Typ[types.Byte]))),
+		types.NewTuple(types.NewVar(token.NoPos, nil, "", types.Universe.Lookup("error").Type())), false)),
+}, nil).Complete()
+
+// checkParamType reports custom types of the parameter which don't implement encoding.TextUnmarshaler.
+// Types of packages which can't be loaded e.g.: of modules missing in the working directory aren't checked,
+// the compiler reports them.
+func (ctx *Context) checkParamType(p *spec.Parameter, r Reference) {
+	switch r := r.(type) {
+	case *Pointer:
+		ctx.checkParamType(p, r.Reference)
+	case *Generic:
+		ctx.checkParamType(p, r.Reference)
+	case *Slice:
+		ctx.checkParamType(p, r.ItemsType.Reference)
+	case *Custom:
+		if ok, checked := ctx.isTextUnmarshaler(r); checked && !ok {
+			ctx.fail(p, "type %s of the parameter %s doesn't implement encoding.TextUnmarshaler", r.Type, p.ExternalName)
+		}
+	}
+}
+
+// isTextUnmarshaler reports whether the pointer to the custom type implements encoding.TextUnmarshaler.
+// Checked is false if the type can't be loaded.
+func (ctx *Context) isTextUnmarshaler(c *Custom) (ok, checked bool) {
+	typ := strings.TrimPrefix(c.Type, "*")
+	if strings.ContainsAny(typ, "[]*") {
+		// Slices, maps and pointers to pointers have no methods.
+		return false, true
+	}
+
+	var obj types.Object
+	if q := qualifier(typ); q == "" {
+		obj = types.Universe.Lookup(typ)
+	} else {
+		importPath := c.Import
+		if importPath == "" {
+			// Types without paths are of standard packages e.g.: time.Time
+			importPath = q
+		}
+		if ctx.importer == nil {
+			ctx.importer = importer.ForCompiler(token.NewFileSet(), "source", nil)
+		}
+		pkg, err := ctx.importer.Import(importPath)
+		if err != nil {
+			return false, false
+		}
+		obj = pkg.Scope().Lookup(typ[len(q)+1:])
+	}
+	tn, isType := obj.(*types.TypeName)
+	if !isType {
+		return false, true
+	}
+	return types.Implements(types.NewPointer(tn.Type()), textUnmarshaler), true
+}
+
+// parseGoType splits the qualified type e.g.: github.com/google/uuid.UUID into the type uuid.UUID
+// and the import path github.com/google/uuid. Types without paths e.g.: json.RawMessage are returned as is.
+func parseGoType(s string) (string, string) {
+	// Pointers and slices of the type e.g.: *github.com/google/uuid.UUID keep their prefix.
+	typ := strings.TrimLeft(s, "*[]")
+	prefix := s[:len(s)-len(typ)]
+	slash, dot := strings.LastIndex(typ, "/"), strings.LastIndex(typ, ".")
+	if slash < 0 || dot < slash {
+		return s, ""
+	}
+	importPath := typ[:dot]
+	return prefix + importName(importPath) + typ[dot:], importPath
+}
+
+// importName returns the default package name of the import path e.g.: yaml for gopkg.in/yaml.v2
+// or uuid for github.com/gofrs/uuid/v5
+func importName(importPath string) string {
+	name := path.Base(importPath)
+	if majorVersion.MatchString(name) && path.Dir(importPath) != "." {
+		name = path.Base(path.Dir(importPath))
+	}
+	if i := strings.Index(name, "."); i > 0 {
+		name = name[:i]
+	}
+	return name
+}
+
+// majorVersion matches the major version suffix of import paths e.g.: v5
+var majorVersion = regexp.MustCompile(`^v[0-9]+$`)
+
+// qualifier returns the package name of the type e.g.: uuid for []*uuid.UUID
+func qualifier(typ string) string {
+	typ = strings.TrimLeft(typ, "*[]")
+	if i := strings.Index(typ, "."); i > 0 {
+		return typ[:i]
+	}
+	return ""
+}
+
+// optional wraps the reference of the optional or nullable property according to the optional strategy.
+// Pointers are not used for slices and maps since nil already means absent.
+func (ctx *Context) optional(p Property) Reference {
+	if _, ok := p.Reference.(*Pointer); ok {
+		return p.Reference
+	}
+	switch ctx.optionalStrategy {
+	case OptionalGeneric:
+		if p.Nullable {
+			return &Generic{Name: "Nullable", Reference: p.Reference}
+		}
+		if !p.Required {
+			return &Generic{Name: "Optional", Reference: p.Reference}
+		}
+		return p.Reference
+	}
+
+	switch p.Reference.(type) {
+	case *Slice, *Dictionary:
+		return p.Reference
+	case *Union:
+		// Unions are structs which omitempty doesn't omit, unset values would be encoded as null.
+		if !p.Required {
+			return &Pointer{Reference: p.Reference}
+		}
+	}
+	if p.Nullable || (!p.Required && ctx.optionalStrategy == OptionalPointer) {
+		return &Pointer{Reference: p.Reference}
+	}
+	return p.Reference
+}
+
+// newEnum registers the Enum of the schema.
+func (ctx *Context) newEnum(p Property, schema *spec.Schema, refName, desc string) *Enum {
+	base := "string"
+	if schema.Type == "integer" {
+		base = (&Integer{Format: schema.Format}).RenderLiteral()
+	}
+	e := &Enum{
+		Name:     refName,
+		AbbrName: ToAbbreviate(desc),
+		Desc:     desc,
+		Base:     base,
+		Format:   schema.Format,
+		Values:   schema.Enum,
+		Lenient:  ctx.lenientEnums,
+	}
+	p.Reference = e
+	ctx.define(refName, p, schema)
+	return e
+}
+
+func (ctx *Context) newUnion(p Property, schema *spec.Schema, name, desc string) *Union {
+	u := &Union{
+		Name:      name,
+		AbbrName:  ToAbbreviate(desc),
+		Desc:      desc,
+		Exclusive: len(schema.OneOf) > 0,
+	}
+	p.Reference = u
+	ctx.define(name, p, schema)
+
+	variants := schema.OneOf
+	if !u.Exclusive {
+		variants = schema.AnyOf
+	}
+	// refs of the variants are matched with the discriminator mapping.
+	refs := []string{}
+	for i, s := range variants {
+		v := ctx.setProperty(s, fmt.Sprintf("Variant%d", i), name, ctx.refName(s, s.Ref), desc)
+		if _, ok := v.Reference.(*Struct); !ok {
+			ctx.fail(s, "unsupported type %q of %s variant, only objects are supported", s.Type, name)
+			continue
+		}
+		u.Variants = append(u.Variants, v)
+		refs = append(refs, s.Ref)
+	}
+
+	if schema.Discriminator == nil {
+		return u
+	}
+	u.Discriminator = schema.Discriminator.PropertyName
+	if len(schema.Discriminator.Mapping) == 0 {
+		for i, v := range u.Variants {
+			value := getRefName(refs[i])
+			if value == "" {
+				value = v.Reference.RenderLiteral()
+			}
+			u.Cases = append(u.Cases, UnionCase{value, v})
+		}
+		return u
+	}
+
+	values := make([]string, 0, len(schema.Discriminator.Mapping))
+	for k := range schema.Discriminator.Mapping {
+		values = append(values, k)
+	}
+	sort.Strings(values)
+	for _, value := range values {
+		// The mapping refers to the variant by the same reference or by the name of the schema.
+		ref := schema.Discriminator.Mapping[value]
+		found := false
+		for i, v := range u.Variants {
+			if refs[i] != "" && (refs[i] == ref || getRefName(refs[i]) == ref) {
+				u.Cases = append(u.Cases, UnionCase{value, v})
+				found = true
+			}
+		}
+		if !found {
+			ctx.fail(schema, "discriminator mapping %s of %s does not match any variant", value, name)
+		}
+	}
+	return u
+}
+
+// setFunctions adds Function for every operation of the PathItem.
+func (ctx *Context) setFunctions(path string, item spec.PathItem) {
+	methods := item.GetMethodsMap()
+	order := operationTypeValues
+	if ctx.keepOrder {
+		order = item.MethodNames()
+	}
+	for _, m := range order {
+		o, ok := methods[m]
+		if !ok || !ctx.selected(o) {
+			continue
+		}
+		ctx.Functions = append(ctx.Functions, Function{
+			Name:              ToCamelCase(true, o.OperationID),
+			Path:              path,
+			OperationType:     operationType(m),
+			Input:             ctx.getParams(o.Parameters, o.RequestBody, o.OperationID),
+			Output:            ctx.getResponses(o.Responses, o.OperationID),
+			ContentTypes:      allowedContentTypes(o.RequestBody),
+			OtherContentTypes: otherContentTypes(o.RequestBody),
+			BodyRequired:      o.RequestBody != nil && o.RequestBody.Required,
+		})
+	}
+}
+
+// selected reports whether the operation is selected by the filters.
+func (ctx *Context) selected(o *spec.Operation) bool {
+	if len(ctx.tags) == 0 && len(ctx.operations) == 0 {
+		return true
+	}
+	for _, id := range ctx.operations {
+		if id == o.OperationID {
+			return true
+		}
+	}
+	for _, t := range ctx.tags {
+		for _, ot := range o.Tags {
+			if t == ot {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (ctx *Context) getParams(ps []*spec.Parameter, rb *spec.RequestBody, opID string) []Param {
+	inputs := []Param{}
+	used := map[string]bool{}
+	for _, p := range ps {
+		if p.Schema == nil {
+			ctx.fail(p, "parameter %s has no schema, content of parameters is not supported", p.ExternalName)
+			continue
+		}
+		prop := ctx.setProperty(p.Schema, p.ExternalName, opID, ctx.refName(p.Schema, p.Schema.Ref), "")
+		prop.Required = p.Required
+		if ctx.optionalStrategy != OptionalValue {
+			prop.Reference = ctx.optional(prop)
+		}
+		ctx.checkParamType(p, prop.Reference)
+		param := newParam(p.In, p.Required, prop)
+		param.Property.Name = ctx.argName(param.Property.Name, used)
+		inputs = append(inputs, param)
+	}
+	for _, k := range getContentTypes(rb) {
+		mt := rb.Content[k]
+		inputs = append(inputs, newParam("body", rb.Required, ctx.setProperty(mt.Schema, "Request", opID, getRefName(rb.Ref), ""))) //TODO:
+	}
+	return inputs
+}
+
+// allowedContentTypes returns sorted content types of the request body accepted by the validator.
+func allowedContentTypes(rb *spec.RequestBody) []string {
+	if rb == nil {
+		return []string{}
+	}
+	return spec.SortedKeys(rb.Content)
+}
+
+// otherContentTypes returns sorted content types of the request body which aren't JSON.
+// Bodies of these types are checked by the validator but not decoded.
+func otherContentTypes(rb *spec.RequestBody) []string {
+	cts := []string{}
+	for _, k := range allowedContentTypes(rb) {
+		if k != "application/json" && !strings.HasSuffix(k, "+json") {
+			cts = append(cts, k)
+		}
+	}
+	return cts
+}
+
+// getContentTypes returns sorted content types of the request body decoded by the generated code.
+func getContentTypes(rb *spec.RequestBody) []string {
+	cts := []string{}
+	if rb == nil {
+		return cts
+	}
+	for k := range rb.Content {
+		if rb.Check(k) {
+			cts = append(cts, k)
+		}
+	}
+	sort.Strings(cts)
+	return cts
+}
+
+// getResponses returns a Param for every status code of the operation. Param of a response without
+// JSON content has no Reference. Params are ordered by matching priority: codes, ranges, default.
+func (ctx *Context) getResponses(rs map[string]*spec.Response, opID string) []Param {
+	outputs := []Param{}
+	for _, c := range spec.SortedKeys(rs) {
+		response := rs[c]
+		if _, err := strconv.Atoi(c); err != nil && !isStatusRange(c) && c != "default" {
+			continue
+		}
+		p := Property{}
+		for _, k := range spec.SortedKeys(response.Content) {
+			if mt := response.Content[k]; response.Check(k) && mt.Schema != nil {
+				p = ctx.setProperty(mt.Schema, c+"Response", opID, ctx.refName(mt.Schema, mt.Schema.Ref), "")
+			}
+		}
+		if isStatusRange(c) {
+			c = strings.ToUpper(c)
+		}
+		outputs = append(outputs, newParam(c, true, p))
+	}
+	sort.Sort(paramsByStatus(outputs))
+	return outputs
+}
+
+// isStatusRange reports whether the code is a range of status codes e.g.: "2XX".
+func isStatusRange(code string) bool {
+	code = strings.ToUpper(code)
+	return len(code) == 3 && code[0] >= '1' && code[0] <= '5' && code[1:] == "XX"
+}
+
+// operationType returns the OperationType of the method e.g.: GET.
+func operationType(method string) OperationType {
+	for i, m := range operationTypeValues {
+		if m == method {
+			return OperationType(i)
+		}
+	}
+	return 0
+}
+
+func (ot OperationType) String() string {
+	return operationTypeValues[ot]
+}
+
+func (v functions) Len() int      { return len(v) }
+func (v functions) Swap(i, j int) { v[i], v[j] = v[j], v[i] }
+func (v functions) Less(i, j int) bool {
+	if v[i].Name != v[j].Name {
+		return v[i].Name < v[j].Name
+	}
+	if v[i].Path != v[j].Path {
+		return v[i].Path < v[j].Path
+	}
+	return v[i].OperationType < v[j].OperationType
+}
+
+func (v paramsByStatus) Len() int      { return len(v) }
+func (v paramsByStatus) Swap(i, j int) { v[i], v[j] = v[j], v[i] }
+func (v paramsByStatus) Less(i, j int) bool {
+	rank := func(p Param) int {
+		switch {
+		case p.In == "default":
+			return 2
+		case isStatusRange(p.In):
+			return 1
+		}
+		return 0
+	}
+	if rank(v[i]) != rank(v[j]) {
+		return rank(v[i]) < rank(v[j])
+	}
+	return v[i].In < v[j].In
+}
+
+func (v propertiesByLiteral) Len() int      { return len(v) }
+func (v propertiesByLiteral) Swap(i, j int) { v[i], v[j] = v[j], v[i] }
+func (v propertiesByLiteral) Less(i, j int) bool {
+	return v[i].Reference.RenderLiteral() < v[j].Reference.RenderLiteral()
+}
+
+func (v propertiesByName) Len() int      { return len(v) }
+func (v propertiesByName) Swap(i, j int) { v[i], v[j] = v[j], v[i] }
+func (v propertiesByName) Less(i, j int) bool {
+	return v[i].Name < v[j].Name
+}
+
+func ToCamelCase(upper bool, names ...string) (out string) {
+	bs := []byte(strings.Join(names, "_"))
+	in := strings.Trim(string(bs), " ")
+
+	isNext := false
+	for i, v := range in {
+		if (v >= 'A' && v <= 'Z') || (v >= 'a' && v <= 'z') {
+			if i == 0 {
+				if !upper {
+					out += strings.ToLower(string(v))
+				} else {
+					out += strings.ToUpper(string(v))
+				}
+			} else {
+				if isNext {
+					out += strings.ToUpper(string(v))
+				} else {
+					out += string(v)
+				}
+			}
+		}
+		if v >= '0' && v <= '9' {
+			out += string(v)
+		}
+		if v == '_' || v == ' ' || v == '-' {
+			isNext = true
+		} else {
+			isNext = false
+		}
+	}
+	return
+}
+
+func ToAbbreviate(name string) string {
+	return abbreviate(name)
+}
+
+func buildJsonTag(p Property) string {
+	jsonTag := ""
+
+	if p.SourceName != "" {
+		jsonTag += p.SourceName
+	}
+	if _, ok := p.Reference.(*Generic); ok {
+		jsonTag += ",omitzero"
+	} else if !p.Required {
+		jsonTag += ",omitempty"
+	}
+
+	if jsonTag == "" {
+		return jsonTag
+	}
+	return fmt.Sprintf("json:\"%s\"", jsonTag)
+}
+
+// RenderOptionalTypes renders the Optional and Nullable types for the generic optional strategy.
+func (c Context) RenderOptionalTypes() string {
+	if c.optionalStrategy != OptionalGeneric {
+		return ""
+	}
+	return renderTemplate("optionalTypes", optionalTypesTemplate, c)
+}
+
+// RenderDiscriminatorHelper renders setDiscriminator used by MarshalJSON of unions with discriminators.
+func (c Context) RenderDiscriminatorHelper() string {
+	for _, r := range c.SortedReferences() {
+		if u, ok := r.Reference.(*Union); ok && u.Discriminator != "" {
+			return renderTemplate("discriminator", discriminatorTemplate, c)
+		}
+	}
+	return ""
+}
+
+func buildValidTag(p Property) string {
+	validateTags := ""
+
+	if p.Required && !p.Nullable {
+		add(&validateTags, "required", ",")
+	}
+	// govalidator validates nested structs even if they are absent, Validate checks them only if they are set.
+	if _, ok := p.Reference.(*Struct); ok && !p.Required {
+		return `valid:"-"`
+	}
+	// govalidator supports the in validator only for strings.
+	if e, ok := p.Reference.(*Enum); ok && e.Base == "string" {
+		add(&validateTags, enumToTag(p.Enum), ",")
+	}
+	//TODO: Disabled, the govalidator does not support type time.Time. Subsequently, you need to add a custom tag.
+	//switch p.Reference.RenderFormat() {
+	//case "date-time", "date":
+	//	if validateTags != "" {
+	//		validateTags += ","
+	//	}
+	//	validateTags += "rfc3339"
+	//}
+
+	if validateTags == "" {
+		return validateTags
+	}
+	return fmt.Sprintf("valid:\"%s\"", validateTags)
+}
+
+func buildExtensionTags(p Property) (tags string) {
+	if len(p.ExtensionTags) <= 0 {
+		return
+	}
+	for _, name := range spec.SortedKeys(p.ExtensionTags) {
+		values := p.ExtensionTags[name]
+		if len(values) <= 0 {
+			continue
+		}
+		add(&tags, fmt.Sprintf("%s:\"%s\"", name, strings.Join(values, ",")), " ")
+	}
+	return
+}
+
+func add(tags *string, that string, sep string) {
+	if tags == nil || that == "" {
+		return
+	}
+	if *tags != "" {
+		*tags += sep
+	}
+	*tags += that
+}
+
+func enumToTag(values []string) string {
+	if len(values) <= 0 {
+		return ""
+	}
+
+	tag := "in("
+	for i, el := range values {
+		tag += el
+		if i < len(values)-1 {
+			tag += "|"
+		}
+	}
+	tag += ")"
+
+	return tag
+}
+
+func abbreviate(s string) string {
+	abbr := make([]byte, 0, len(s))
+	lex := make([]byte, 0, len(s))
+
+	f := true
+	for _, ch := range s {
+		if ch == '.' {
+			f = true
+			continue
+		}
+		if f {
+			abbr = append(abbr, byte(unicode.ToUpper(ch)))
+			lex = make([]byte, 0, len(s))
+			f = false
+		} else {
+			lex = append(lex, byte(ch))
+		}
+	}
+	abbr = append(abbr, lex...)
+
+	return string(abbr)
+}
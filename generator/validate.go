@@ -0,0 +1,418 @@
+package generator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	checksTemplate = `
+	{{- range $c := $.Checks }}
+	if {{ $c.Cond }} {
+	{{- if $.Native }}
+		errs.Add({{ $.Field }}, {{ printf "%q" $c.Message }})
+	{{- else }}
+		return false, fmt.Errorf("%s: %s", {{ $.Field }}, {{ printf "%q" $c.Message }})
+	{{- end }}
+	}
+	{{- end }}
+	{{- $.Nested }}`
+	nestedValidateTemplate = `
+	{{- if $.Native }}
+	{{ $.Name }}.validate({{ $.Field }}, errs)
+	{{- else }}
+	if ok, err := {{ $.Name }}.Validate(); !ok {
+		return ok, fmt.Errorf("%s: %v", {{ $.Field }}, err)
+	}
+	{{- end }}`
+	sliceChecksTemplate = `
+	for {{ $.Index }}, {{ $.Item }} := range {{ $.Name }} {
+		{{- $.Checks }}
+	}`
+	uniqueItemsTemplate = `func() bool {
+		for i := range {{ $.Name }} {
+			for j := i + 1; j < len({{ $.Name }}); j++ {
+				{{- if $.Comparable }}
+				if {{ $.Name }}[i] == {{ $.Name }}[j] {
+				{{- else }}
+				if reflect.DeepEqual({{ $.Name }}[i], {{ $.Name }}[j]) {
+				{{- end }}
+					return true
+				}
+			}
+		}
+		return false
+	}()`
+	validationErrorsTemplate = `
+// ValidationError is a violation of the field constraint.
+type ValidationError struct {
+	// Field is a path of the field e.g.: pet.nested.omg.very_omg_type
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// ValidationErrors are all violations found by Validate.
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+func (e *ValidationErrors) Add(field, message string) {
+	*e = append(*e, &ValidationError{Field: field, Message: message})
+}
+
+// validFormat reports whether the value conforms to the string format.
+func validFormat(format, value string) bool {
+	switch format {
+	case "email":
+		_, err := mail.ParseAddress(value)
+		return err == nil
+	case "uri":
+		u, err := url.Parse(value)
+		return err == nil && u.IsAbs()
+	case "uuid":
+		return uuidPattern.MatchString(value)
+	case "ipv4":
+		ip := net.ParseIP(value)
+		return ip != nil && !strings.Contains(value, ":")
+	case "ipv6":
+		ip := net.ParseIP(value)
+		return ip != nil && strings.Contains(value, ":")
+	case "hostname":
+		return hostnamePattern.MatchString(value)
+	case "byte":
+		_, err := base64.StdEncoding.DecodeString(value)
+		return err == nil
+	}
+	return true
+}
+
+var (
+	uuidPattern     = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+	hostnamePattern = regexp.MustCompile(` + "`" + `^([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)*[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$` + "`" + `)
+)
+`
+	patternsTemplate = `
+{{- if $.Patterns }}
+var (
+	{{- range $p := $.Patterns }}
+	{{ $p.Name }} = regexp.MustCompile({{ printf "%q" $p.Expr }})
+	{{- end }}
+)
+{{- end }}
+`
+)
+
+// Pattern is a compiled regular expression of the pattern validation keyword.
+type Pattern struct {
+	Name string
+	Expr string
+}
+
+type constraint struct {
+	Cond    string
+	Message string
+}
+
+// RenderPatterns renders variables of compiled patterns used by Validate methods.
+func (c Context) RenderPatterns() string {
+	patterns := []Pattern{}
+	for _, r := range c.SortedReferences() {
+		s, ok := r.Reference.(*Struct)
+		if !ok {
+			continue
+		}
+		for _, p := range s.Properties {
+			patterns = append(patterns, collectPatterns(p, s.Name+p.Name)...)
+		}
+	}
+	return renderTemplate("patterns", patternsTemplate, struct{ Patterns []Pattern }{patterns})
+}
+
+// RenderValidationErrors renders types of errors returned by native Validate methods.
+func (c Context) RenderValidationErrors() string {
+	return renderTemplate("validationErrors", validationErrorsTemplate, c)
+}
+
+// collectPatterns returns patterns of the property and its items. The base is a prefix of the variables names.
+func collectPatterns(p Property, base string) []Pattern {
+	patterns := []Pattern{}
+	if p.Validation.Pattern != "" {
+		patterns = append(patterns, Pattern{patternName(base), p.Validation.Pattern})
+	}
+	p, _, _ = unwrap(p, "")
+	switch r := p.Reference.(type) {
+	case *Slice:
+		patterns = append(patterns, collectPatterns(r.ItemsType, base+"Item")...)
+	case *Dictionary:
+		patterns = append(patterns, collectPatterns(r.ItemsType, base+"Value")...)
+	}
+	return patterns
+}
+
+func patternName(base string) string {
+	return ToCamelCase(false, base) + "Pattern"
+}
+
+// checker renders checks of the validation keywords. Native checks don't use govalidator and collect all violations.
+type checker struct {
+	native bool
+}
+
+// checks renders checks of the validation keywords of the value and recursive validation of its nested values.
+// The field is Go expression of the value path in error messages, the depth is a nesting level of items loops.
+func (c checker) checks(p Property, name, field, base string, depth int) string {
+	// The wrapped value is checked only if it is set.
+	if wrapped, value, cond := unwrap(p, name); cond != "" {
+		checks := c.checks(wrapped, value, field, base, depth)
+		if checks == "" {
+			return ""
+		}
+		return fmt.Sprintf("if %s {%s\n}", cond, checks)
+	}
+
+	v := p.Validation
+	checks := []constraint{}
+
+	value := name
+	switch p.Reference.(type) {
+	case *Integer, *Number:
+		if v.Minimum != nil {
+			op, msg := "<", ">="
+			if v.ExclusiveMinimum {
+				op, msg = "<=", ">"
+			}
+			m := formatFloat(*v.Minimum)
+			checks = append(checks, constraint{fmt.Sprintf("float64(%s) %s %s", value, op, m), fmt.Sprintf("must be %s %s", msg, m)})
+		}
+		if v.Maximum != nil {
+			op, msg := ">", "<="
+			if v.ExclusiveMaximum {
+				op, msg = ">=", "<"
+			}
+			m := formatFloat(*v.Maximum)
+			checks = append(checks, constraint{fmt.Sprintf("float64(%s) %s %s", value, op, m), fmt.Sprintf("must be %s %s", msg, m)})
+		}
+		if v.MultipleOf != nil {
+			m := formatFloat(*v.MultipleOf)
+			checks = append(checks, constraint{
+				fmt.Sprintf("math.Abs(math.Remainder(float64(%s), %s)) > 1e-9", value, m),
+				fmt.Sprintf("must be a multiple of %s", m),
+			})
+		}
+	case *Enum:
+		checks = append(checks, constraint{
+			fmt.Sprintf("!%s.IsValid()", value),
+			fmt.Sprintf("must be one of %s", strings.Join(p.Enum, ", ")),
+		})
+	case *String:
+		if v.MinLength != nil {
+			checks = append(checks, constraint{
+				fmt.Sprintf("utf8.RuneCountInString(%s) < %d", value, *v.MinLength),
+				fmt.Sprintf("length must be at least %d", *v.MinLength),
+			})
+		}
+		if v.MaxLength != nil {
+			checks = append(checks, constraint{
+				fmt.Sprintf("utf8.RuneCountInString(%s) > %d", value, *v.MaxLength),
+				fmt.Sprintf("length must be at most %d", *v.MaxLength),
+			})
+		}
+		if v.Pattern != "" {
+			checks = append(checks, constraint{
+				fmt.Sprintf("!%s.MatchString(%s)", patternName(base), value),
+				fmt.Sprintf("must match the pattern %s", v.Pattern),
+			})
+		}
+		if format := p.Reference.(*String).Format; formatValidators[format] != "" {
+			cond := fmt.Sprintf("!govalidator.%s(%s)", formatValidators[format], value)
+			if c.native {
+				cond = fmt.Sprintf("!validFormat(%q, %s)", format, value)
+			}
+			checks = append(checks, constraint{cond, fmt.Sprintf("must be a valid %s", format)})
+		}
+	case *Slice:
+		if v.MinItems != nil {
+			checks = append(checks, constraint{
+				fmt.Sprintf("len(%s) < %d", value, *v.MinItems),
+				fmt.Sprintf("must have at least %d items", *v.MinItems),
+			})
+		}
+		if v.MaxItems != nil {
+			checks = append(checks, constraint{
+				fmt.Sprintf("len(%s) > %d", value, *v.MaxItems),
+				fmt.Sprintf("must have at most %d items", *v.MaxItems),
+			})
+		}
+		if v.UniqueItems {
+			checks = append(checks, constraint{
+				renderTemplate(
+					"uniqueItems", uniqueItemsTemplate,
+					struct {
+						Name       string
+						Comparable bool
+					}{value, isComparable(p.Reference.(*Slice).ItemsType.Reference)}),
+				"items must be unique",
+			})
+		}
+	case *Dictionary:
+		if v.MinProperties != nil {
+			checks = append(checks, constraint{
+				fmt.Sprintf("len(%s) < %d", value, *v.MinProperties),
+				fmt.Sprintf("must have at least %d properties", *v.MinProperties),
+			})
+		}
+		if v.MaxProperties != nil {
+			checks = append(checks, constraint{
+				fmt.Sprintf("len(%s) > %d", value, *v.MaxProperties),
+				fmt.Sprintf("must have at most %d properties", *v.MaxProperties),
+			})
+		}
+	}
+
+	nested := c.nested(p, name, field, base, depth)
+	if len(checks) == 0 && nested == "" {
+		return ""
+	}
+	return c.render(checks, field, nested)
+}
+
+func (c checker) render(checks []constraint, field, nested string) string {
+	return renderTemplate(
+		"checks", checksTemplate,
+		struct {
+			Checks []constraint
+			Field  string
+			Nested string
+			Native bool
+		}{checks, field, nested, c.native})
+}
+
+// nested renders validation of structs, items of slices and values of dictionaries.
+func (c checker) nested(p Property, name, field, base string, depth int) string {
+	index, item := fmt.Sprintf("i%d", depth), fmt.Sprintf("item%d", depth)
+
+	switch r := p.Reference.(type) {
+	case *Struct, *Union:
+		return renderTemplate(
+			"nestedValidate", nestedValidateTemplate,
+			struct {
+				Name, Field string
+				Native      bool
+			}{name, field, c.native})
+	case *Slice:
+		checks := c.checks(r.ItemsType, item, fmt.Sprintf(`fmt.Sprintf("%%s[%%d]", %s, %s)`, field, index), base+"Item", depth+1)
+		if checks == "" {
+			return ""
+		}
+		return renderTemplate(
+			"sliceChecks", sliceChecksTemplate,
+			struct{ Name, Index, Item, Checks string }{name, index, item, checks})
+	case *Dictionary:
+		checks := c.checks(r.ItemsType, item, fmt.Sprintf(`fmt.Sprintf("%%s[%%s]", %s, %s)`, field, index), base+"Value", depth+1)
+		if checks == "" {
+			return ""
+		}
+		return renderTemplate(
+			"sliceChecks", sliceChecksTemplate,
+			struct{ Name, Index, Item, Checks string }{name, index, item, checks})
+	}
+	return ""
+}
+
+// unwrap returns the property wrapped by Pointer or Generic, Go expression of its value and condition
+// of the value being set. The condition is empty for not wrapped properties.
+func unwrap(p Property, name string) (Property, string, string) {
+	switch r := p.Reference.(type) {
+	case *Pointer:
+		wrapped := p
+		wrapped.Reference = r.Reference
+		switch r.Reference.(type) {
+		case *Struct, *Union:
+			// Methods of structs are called by pointer.
+			return wrapped, name, name + " != nil"
+		}
+		return wrapped, "(*" + name + ")", name + " != nil"
+	case *Generic:
+		wrapped := p
+		wrapped.Reference = r.Reference
+		return wrapped, name + ".Value", r.presenceCond(name)
+	}
+	return p, name, ""
+}
+
+// isComparable reports whether values of the type are comparable with == operator.
+func isComparable(r Reference) bool {
+	switch r.(type) {
+	case *String, *Integer, *Number, *Bool:
+		return true
+	}
+	return false
+}
+
+// emptyCond returns condition of the required value being missing or empty string if it can't be checked.
+// Zero numbers and booleans are valid values, so only strings, times, pointers, slices and maps are checked.
+func emptyCond(r Reference, name string) string {
+	switch r.(type) {
+	case *String:
+		return fmt.Sprintf("%s == \"\"", name)
+	case *Enum:
+		if r.(*Enum).Base == "string" {
+			return fmt.Sprintf("%s == \"\"", name)
+		}
+	case *Datetime:
+		return fmt.Sprintf("%s.IsZero()", name)
+	case *Pointer, *Slice, *Dictionary:
+		return fmt.Sprintf("%s == nil", name)
+	case *Generic:
+		return fmt.Sprintf("!%s.Set", name)
+	}
+	return ""
+}
+
+// presenceCond returns condition of the value being set or empty string if it can't be checked.
+func presenceCond(r Reference, name string) string {
+	switch r.(type) {
+	case *String:
+		return fmt.Sprintf("%s != \"\"", name)
+	case *Integer, *Number:
+		return fmt.Sprintf("%s != 0", name)
+	case *Enum:
+		if r.(*Enum).Base == "string" {
+			return fmt.Sprintf("%s != \"\"", name)
+		}
+		return fmt.Sprintf("%s != 0", name)
+	case *Slice, *Dictionary:
+		// Empty collections are present, minItems and minProperties apply to them.
+		return fmt.Sprintf("%s != nil", name)
+	case *Struct:
+		// The absent object is the zero struct, its required properties aren't checked.
+		return fmt.Sprintf("!reflect.ValueOf(%s).IsZero()", name)
+	}
+	return ""
+}
+
+// formatValidators maps string formats to govalidator functions.
+var formatValidators = map[string]string{
+	"email":    "IsEmail",
+	"uri":      "IsRequestURI",
+	"uuid":     "IsUUID",
+	"ipv4":     "IsIPv4",
+	"ipv6":     "IsIPv6",
+	"hostname": "IsDNSName",
+	"byte":     "IsBase64",
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
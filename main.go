@@ -2,36 +2,58 @@ package main
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
+	"github.com/oasgo/oasgo/generator"
+	"github.com/oasgo/oasgo/spec"
 	"github.com/spf13/cobra"
 )
 
-var spec, packageName, destination string
-var isAbbreviate bool
+// Exit codes of the failures. 2 is skipped since it is the exit code of Go panics.
+const (
+	exitUsage    = 1
+	exitSpec     = 3
+	exitGenerate = 4
+	exitOutput   = 5
+	// exitStale is returned by the check mode if generated files differ.
+	exitStale = 6
+)
+
+var specPath, packageName, destination, outputDir, validator, optional, configPath string
+var isAbbreviate, lenientEnums, check, keepOrder bool
+var typeMap []string
 
 var parseCmd = &cobra.Command{
 	Use:   "parse",
 	Short: "parse openapi spec and prints swagger. Need for debug stuff",
 	Run: func(cmd *cobra.Command, args []string) {
-		s := parse(spec)
+		s := load()
 		fmt.Println(s)
 	},
 }
 var genCmd = &cobra.Command{
 	Use:   "generate",
 	Short: "generate golang file and print it to the output",
+	Run: func(cmd *cobra.Command, args []string) {
+		if configPath == "" {
+			cmd.Help()
+			return
+		}
+		c, err := loadConfig(configPath)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(exitUsage)
+		}
+		os.Exit(c.run())
+	},
 }
 
 var clientCmd = &cobra.Command{
 	Use:   "client",
 	Short: "generate client golang file and print it to the output",
 	Run: func(cmd *cobra.Command, args []string) {
-		s := parse(spec)
-		if packageName == "" {
-			packageName = "client"
-		}
-		renderClient(s, packageName, destination, isAbbreviate)
+		run("client")
 	},
 }
 
@@ -39,27 +61,128 @@ var dtoCmd = &cobra.Command{
 	Use:   "dto",
 	Short: "generates DTO structs",
 	Run: func(cmd *cobra.Command, args []string) {
-		s := parse(spec)
-		if packageName == "" {
-			packageName = "dto"
-		}
-		renderDTO(s, packageName, destination, isAbbreviate)
+		run("dto")
+	},
+}
+
+var serverCmd = &cobra.Command{
+	Use:   "server",
+	Short: "generate server interface and http handler golang file and print it to the output",
+	Run: func(cmd *cobra.Command, args []string) {
+		run("server")
+	},
+}
+
+var mocksCmd = &cobra.Command{
+	Use:   "mocks",
+	Short: "generate mock of the client interface into the package of the client and print it to the output",
+	Run: func(cmd *cobra.Command, args []string) {
+		run("mocks")
 	},
 }
 
 func main() {
 	var rootCmd = &cobra.Command{}
 	rootCmd.AddCommand(parseCmd, genCmd)
-	rootCmd.PersistentFlags().StringVarP(&spec, "file", "f", "", "path to swagger spec")
-	genCmd.AddCommand(clientCmd, dtoCmd)
+	rootCmd.PersistentFlags().StringVarP(&specPath, "file", "f", "", "path to swagger spec")
+	genCmd.AddCommand(clientCmd, serverCmd, dtoCmd, mocksCmd)
+	genCmd.Flags().StringVar(&configPath, "config", "", "path to config of specs and targets e.g.: oasgo.yaml")
 	genCmd.PersistentFlags().StringVarP(&packageName, "package_name", "n", "", "name for generated package")
 	genCmd.PersistentFlags().StringVarP(&destination, "destination", "d", "", "destination for generated package")
+	genCmd.PersistentFlags().StringVarP(&outputDir, "output-dir", "o", "", "directory for generated package split into files e.g.: types.go, enums.go and client.go")
+	dtoCmd.Flags().StringVar(&validator, "validator", "govalidator", "kind of generated Validate methods: govalidator or native")
 	genCmd.PersistentFlags().BoolVarP(&isAbbreviate, "abbreviate", "a", false, "abbreviate the names of generated structures")
-	rootCmd.Execute()
+	genCmd.PersistentFlags().BoolVar(&lenientEnums, "lenient-enums", false, "accept unknown values when decoding enums")
+	genCmd.PersistentFlags().StringVar(&optional, "optional", generator.OptionalValue, "rendering of optional and nullable fields: value, pointer or generic")
+	genCmd.PersistentFlags().BoolVar(&keepOrder, "keep-order", false, "keep the declaration order of struct fields and operations instead of sorting them by names")
+	genCmd.PersistentFlags().BoolVar(&check, "check", false, "compare generated code with the destination instead of writing it, print the diff if they differ")
+	genCmd.PersistentFlags().StringSliceVar(&typeMap, "type-map", nil, "map the format to the Go type e.g.: uuid=github.com/google/uuid.UUID")
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(exitUsage)
+	}
+}
+
+// run generates the kind of code by flags.
+func run(kind string) {
+	if destination != "" && outputDir != "" {
+		fmt.Fprintln(os.Stderr, "--destination and --output-dir can't be used together")
+		os.Exit(exitUsage)
+	}
+	s := load()
+	if packageName == "" {
+		packageName = packages[kind]
+	}
+	o := options()
+	if outputDir == "" {
+		write(generator.Generate(kind, s, o))
+		return
+	}
+
+	files, err := generator.Files(kind, s, o)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(exitGenerate)
+	}
+	if exit, err := outputFiles(outputDir, kind, files); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(exit)
+	}
 }
 
-// getRefName returns last element from ref string e.g.: "#/components/schemas/Pet"
-func getRefName(ref string) string {
-	path := strings.Split(ref, "/")
-	return path[len(path)-1]
+// load loads the spec set by the flag.
+func load() *spec.Swagger {
+	s, err := spec.Load(specPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(exitSpec)
+	}
+	return s
+}
+
+// options returns generation options set by flags.
+func options() generator.Options {
+	types := map[string]string{}
+	for _, m := range typeMap {
+		parts := strings.SplitN(m, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			fmt.Fprintf(os.Stderr, "invalid type mapping %s, expected format=type\n", m)
+			os.Exit(exitUsage)
+		}
+		types[parts[0]] = parts[1]
+	}
+	o := generator.Options{
+		PackageName:  packageName,
+		IsAbbreviate: isAbbreviate,
+		Validator:    validator,
+		LenientEnums: lenientEnums,
+		Optional:     optional,
+		TypeMap:      types,
+		KeepOrder:    keepOrder,
+	}
+	if err := o.Validate(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(exitUsage)
+	}
+	return o
+}
+
+// write writes the generated source to the destination file or to the output.
+// Nothing is written if the generation failed.
+func write(src []byte, err error) {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(exitGenerate)
+	}
+	if destination == "" {
+		if check {
+			fmt.Fprintln(os.Stderr, "--check requires --destination or --output-dir")
+			os.Exit(exitUsage)
+		}
+		os.Stdout.Write(src)
+		return
+	}
+	if exit, err := output(destination, src); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(exit)
+	}
 }
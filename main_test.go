@@ -0,0 +1,53 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestMain runs main with the arguments of OASGO_ARGS instead of tests, so exit codes of main can be checked.
+func TestMain(m *testing.M) {
+	if args := os.Getenv("OASGO_ARGS"); args != "" {
+		os.Args = append([]string{"oasgo"}, strings.Fields(args)...)
+		main()
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// exitCode runs main with the arguments in a subprocess and returns its exit code.
+func exitCode(t *testing.T, args string) int {
+	cmd := exec.Command(os.Args[0])
+	cmd.Env = append(os.Environ(), "OASGO_ARGS="+args)
+	err := cmd.Run()
+	if e, ok := err.(*exec.ExitError); ok {
+		return e.ExitCode()
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return 0
+}
+
+func TestExitCodes(t *testing.T) {
+	tests := []struct {
+		args string
+		code int
+	}{
+		{"generate dto --bogus", exitUsage},
+		{"generate dto -f testdata/pets.yaml --chek", exitUsage},
+		{"generate --bogus", exitUsage},
+		{"generate dto -f testdata/pets.yaml --optional maybe", exitUsage},
+		{"generate dto -f testdata/missing.yaml", exitSpec},
+		{"generate dto -f testdata/pets.yaml", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			assert.Equal(t, tt.code, exitCode(t, tt.args))
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/oasgo/oasgo/generator"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// output writes the generated source to the file or, in the check mode, compares it with the file.
+// It returns the exit code of the failure.
+func output(path string, src []byte) (int, error) {
+	if !check {
+		if err := writeFile(path, src); err != nil {
+			return exitOutput, err
+		}
+		return 0, nil
+	}
+	diff, err := diffFile(path, src)
+	if err != nil {
+		return exitOutput, err
+	}
+	if diff != "" {
+		fmt.Print(diff)
+		return exitStale, fmt.Errorf("%s is stale, regenerate it", path)
+	}
+	return 0, nil
+}
+
+// diffFile returns the unified diff of the file and the generated source or empty string if they are equal.
+// The missing file is compared as empty.
+func diffFile(path string, src []byte) (string, error) {
+	current, err := ioutil.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return "", err
+	}
+	if string(current) == string(src) {
+		return "", nil
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(current)),
+		B:        difflib.SplitLines(string(src)),
+		FromFile: path,
+		ToFile:   path + " (generated)",
+		Context:  3,
+	})
+}
+
+// generatedHeader starts every generated file, only files with it are removed as stale.
+const generatedHeader = "// Code generated by https://github.com/oasgo/oasgo. DO NOT EDIT."
+
+// outputFiles outputs the generated files of the kind into the directory and removes or, in the check mode, reports
+// files of the kind left there by previous runs. Files of other kinds e.g.: mocks.go of the client directory are kept.
+// All files are output even if some fail, the exit code of the first failure is returned.
+func outputFiles(dir, kind string, files map[string][]byte) (int, error) {
+	names := []string{}
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	code, failures := 0, []string{}
+	fail := func(exit int, err error) {
+		if code == 0 {
+			code = exit
+		}
+		failures = append(failures, err.Error())
+	}
+	for _, name := range names {
+		if exit, err := output(filepath.Join(dir, name), files[name]); err != nil {
+			fail(exit, err)
+		}
+	}
+
+	stale, err := staleFiles(dir, kind, files)
+	if err != nil {
+		fail(exitOutput, err)
+	}
+	for _, path := range stale {
+		if check {
+			fail(exitStale, fmt.Errorf("%s is stale, remove it", path))
+			continue
+		}
+		if err := os.Remove(path); err != nil {
+			fail(exitOutput, err)
+		}
+	}
+
+	if len(failures) > 0 {
+		return code, errors.New(strings.Join(failures, "\n"))
+	}
+	return 0, nil
+}
+
+// staleFiles returns sorted paths of generated files of the kind in the directory which aren't generated now.
+func staleFiles(dir, kind string, files map[string][]byte) ([]string, error) {
+	stale := []string{}
+	for _, name := range generator.FileNames(kind) {
+		if _, ok := files[name]; ok {
+			continue
+		}
+		path := filepath.Join(dir, name)
+		data, err := ioutil.ReadFile(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if bytes.HasPrefix(data, []byte(generatedHeader)) {
+			stale = append(stale, path)
+		}
+	}
+	sort.Strings(stale)
+	return stale, nil
+}
+
+// writeFile writes the generated source creating missing directories.
+// The source is written to the temporary file which replaces the file, so the file is never left partially written.
+func writeFile(path string, src []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(dir, "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(src); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
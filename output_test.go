@@ -0,0 +1,155 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const generated = generatedHeader + "\n\npackage client\n"
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	for name, src := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func dirFiles(t *testing.T, dir string) []string {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := []string{}
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	sort.Strings(names)
+	return names
+}
+
+// captureStdout returns what f prints to the standard output.
+func captureStdout(t *testing.T, f func()) string {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	out := make(chan string)
+	go func() {
+		b, _ := ioutil.ReadAll(r)
+		out <- string(b)
+	}()
+	f()
+	w.Close()
+	return <-out
+}
+
+func TestOutputChecksFile(t *testing.T) {
+	check = true
+	defer func() { check = false }()
+
+	src := generated + "\ntype Pet struct{}\n"
+	tests := []struct {
+		name    string
+		current *string
+		exit    int
+		diff    []string
+	}{
+		{
+			name:    "up to date",
+			current: &src,
+		},
+		{
+			name:    "out of date",
+			current: func() *string { s := generated; return &s }(),
+			exit:    exitStale,
+			diff:    []string{" package client\n", "+type Pet struct{}\n"},
+		},
+		{
+			name: "missing",
+			exit: exitStale,
+			diff: []string{"+" + generatedHeader + "\n", "+package client\n", "+type Pet struct{}\n"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "client.go")
+			if tt.current != nil {
+				writeFiles(t, filepath.Dir(path), map[string]string{"client.go": *tt.current})
+			}
+
+			var exit int
+			var err error
+			diff := captureStdout(t, func() { exit, err = output(path, []byte(src)) })
+			assert.Equal(t, tt.exit, exit)
+			if tt.exit == 0 {
+				assert.NoError(t, err)
+				assert.Empty(t, diff)
+				return
+			}
+			assert.EqualError(t, err, path+" is stale, regenerate it")
+			assert.True(t, strings.HasPrefix(diff, "--- "+path+"\n+++ "+path+" (generated)\n"), diff)
+			for _, line := range tt.diff {
+				assert.Contains(t, diff, line)
+			}
+
+			current, err := ioutil.ReadFile(path)
+			if tt.current == nil {
+				assert.True(t, os.IsNotExist(err), "%s is created", path)
+				return
+			}
+			assert.Equal(t, *tt.current, string(current))
+		})
+	}
+}
+
+func TestOutputFilesRemovesStaleFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"client.go":      generated,
+		"enums.go":       generated,
+		"mocks.go":       generated,
+		"dto.go":         generated,
+		"helpers.go":     "package client\n",
+		"client_test.go": "package client\n",
+		"notes.txt":      generated,
+	})
+
+	exit, err := outputFiles(dir, "client", map[string][]byte{"client.go": []byte(generated), "types.go": []byte(generated)})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, exit)
+	assert.Equal(t, []string{"client.go", "client_test.go", "dto.go", "helpers.go", "mocks.go", "notes.txt", "types.go"}, dirFiles(t, dir))
+}
+
+func TestOutputFilesReportsStaleFiles(t *testing.T) {
+	check = true
+	defer func() { check = false }()
+
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{"client.go": generated, "enums.go": generated, "mocks.go": generated})
+
+	exit, err := outputFiles(dir, "client", map[string][]byte{"client.go": []byte(generated)})
+	assert.Equal(t, exitStale, exit)
+	assert.EqualError(t, err, filepath.Join(dir, "enums.go")+" is stale, remove it")
+	assert.Equal(t, []string{"client.go", "enums.go", "mocks.go"}, dirFiles(t, dir))
+}
+
+func TestOutputFilesIntoMissingDirectory(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "client")
+
+	exit, err := outputFiles(dir, "client", map[string][]byte{"client.go": []byte(generated)})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, exit)
+	_, err = os.Stat(filepath.Join(dir, "client.go"))
+	assert.NoError(t, err)
+}
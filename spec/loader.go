@@ -0,0 +1,356 @@
+package spec
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+	yaml3 "gopkg.in/yaml.v3"
+)
+
+// loader loads specification documents and resolves references within and between them.
+type loader struct {
+	documents map[string]*document
+	// index of referable nodes by absolute reference e.g.: "pets.yaml#/components/schemas/Pet"
+	index map[string]interface{}
+	state map[interface{}]resolveState
+	// locations of the nodes of all documents.
+	locations   map[interface{}]Location
+	diagnostics Diagnostics
+}
+
+// document is a loaded specification file.
+type document struct {
+	location string
+	data     []byte
+	raw      interface{}
+	tree     *yaml3.Node
+	swagger  *Swagger
+}
+
+type resolveState int
+
+const (
+	unresolved resolveState = iota
+	resolving
+	resolved
+)
+
+func newLoader() *loader {
+	return &loader{
+		documents: make(map[string]*document),
+		index:     make(map[string]interface{}),
+		state:     make(map[interface{}]resolveState),
+		locations: make(map[interface{}]Location),
+	}
+}
+
+// load reads the document by the location (path or URL) and resolves all its references.
+// Every document is read only once.
+func (l *loader) load(location string) (*document, error) {
+	// Paths are cleaned like the resolved ones, so ./api.yaml and api.yaml are the same document.
+	if u, err := url.Parse(location); err != nil || !u.IsAbs() {
+		location = filepath.Clean(location)
+	}
+	if d, ok := l.documents[location]; ok {
+		return d, nil
+	}
+
+	data, err := readFile(location)
+	if err != nil {
+		return nil, err
+	}
+	return l.add(location, data)
+}
+
+// add registers the document by the location and resolves all its references.
+func (l *loader) add(location string, data []byte) (*document, error) {
+	s, err := newSwagger(data)
+	if err != nil {
+		return nil, syntaxError(location, err)
+	}
+
+	d := &document{location: location, data: data, swagger: s}
+	l.documents[location] = d
+
+	for k, v := range s.Components.Schemas {
+		l.index[absoluteRef(location, "components", "schemas", k)] = v
+	}
+	for k, v := range s.Components.Parameters {
+		l.index[absoluteRef(location, "components", "parameters", k)] = v
+	}
+	for k, v := range s.Components.RequestBodies {
+		l.index[absoluteRef(location, "components", "requestBodies", k)] = v
+	}
+	for k, v := range s.Components.Responses {
+		l.index[absoluteRef(location, "components", "responses", k)] = v
+	}
+
+	l.locate(d, *s, nil)
+	l.resolve(d, *s)
+	for _, item := range s.Paths {
+		item.mergeParameters()
+	}
+	return d, nil
+}
+
+// resolve replaces every referencing node under the root by a copy of the referenced one.
+// References are relative to the document d. Unresolved references are collected as diagnostics.
+func (l *loader) resolve(d *document, root interface{}) {
+	Inspect(root, func(n interface{}) bool {
+		if p, ok := n.(*Parameter); ok && p.Ref == "" && p.ExternalName == "" {
+			p.ExternalName = p.Name
+		}
+
+		ref := refOf(n)
+		if ref == "" {
+			return true
+		}
+		// Nodes of the copy are shared with the referenced node, so they are resolved along with it.
+		switch l.state[n] {
+		case unresolved:
+			l.state[n] = resolving
+			if err := l.lookup(d, ref, n); err != nil {
+				l.fail(n, err)
+			}
+			l.state[n] = resolved
+		case resolving:
+			l.fail(n, fmt.Errorf("circular reference %s", ref))
+		}
+		return false
+	})
+}
+
+// fail records the diagnostic of the node.
+func (l *loader) fail(n interface{}, err error) {
+	if ds, ok := err.(Diagnostics); ok {
+		l.diagnostics = append(l.diagnostics, ds...)
+		return
+	}
+	l.diagnostics = append(l.diagnostics, Diagnostic{Location: l.locations[n], Message: err.Error()})
+}
+
+// syntaxError converts the decoding error of the document into diagnostics with the line of the error if it is known.
+func syntaxError(location string, err error) Diagnostics {
+	d := Diagnostic{Location: Location{File: location}, Message: err.Error()}
+	if m := syntaxErrorLine.FindStringSubmatch(d.Message); m != nil {
+		d.Location.Line, _ = strconv.Atoi(m[1])
+		d.Location.Column = 1
+	}
+	return Diagnostics{d}
+}
+
+var syntaxErrorLine = regexp.MustCompile(`line (\d+):`)
+
+// lookup finds the node referenced from the document and copies it into the dest keeping the reference.
+func (l *loader) lookup(d *document, ref string, dest interface{}) error {
+	location, pointer := SplitRef(ref)
+
+	if location != "" {
+		location, err := resolveLocation(d.location, location)
+		if err != nil {
+			return fmt.Errorf("unresolved reference %s: %s", ref, err)
+		}
+		external, err := l.load(location)
+		if ds, ok := err.(Diagnostics); ok {
+			// Syntax errors of the external document are reported at their own location.
+			return ds
+		}
+		if err != nil {
+			return fmt.Errorf("unresolved reference %s: %s", ref, err)
+		}
+		d = external
+	}
+
+	tokens := splitPointer(pointer)
+	key := absoluteRef(d.location, tokens...)
+
+	source, ok := l.index[key]
+	if !ok {
+		node, err := d.find(tokens)
+		if err != nil {
+			return fmt.Errorf("unresolved reference %s: %s", ref, err)
+		}
+		bs, err := yaml.Marshal(node)
+		if err != nil {
+			return err
+		}
+		source = newNode(dest)
+		if err := yaml.Unmarshal(bs, source); err != nil {
+			return fmt.Errorf("unresolved reference %s: %s", ref, err)
+		}
+		l.index[key] = source
+
+		// The copy has its own nodes, so its references must be resolved against the document it came from.
+		l.locate(d, source, tokens)
+		l.resolve(d, source)
+	}
+
+	if reflect.TypeOf(source) != reflect.TypeOf(dest) {
+		return fmt.Errorf("reference %s is not a %s", ref, reflect.TypeOf(dest).Elem().Name())
+	}
+	// The referenced node may be a reference itself which is not resolved yet.
+	if refOf(source) != "" {
+		l.resolve(d, source)
+	}
+	assign(dest, source)
+	if loc, ok := l.locations[source]; ok {
+		l.locations[dest] = loc
+	}
+	return nil
+}
+
+// absoluteRef builds the reference to the node of the document by unescaped JSON Pointer tokens.
+func absoluteRef(location string, tokens ...string) string {
+	return location + "#" + pointerOf(tokens)
+}
+
+// refOf returns reference of the node if it is able to be a reference.
+func refOf(n interface{}) string {
+	switch v := n.(type) {
+	case *Schema:
+		if v != nil {
+			return v.Ref
+		}
+	case *Parameter:
+		if v != nil {
+			return v.Ref
+		}
+	case *RequestBody:
+		if v != nil {
+			return v.Ref
+		}
+	case *Response:
+		if v != nil {
+			return v.Ref
+		}
+	}
+	return ""
+}
+
+// newNode returns an empty node of the same kind as the n.
+func newNode(n interface{}) interface{} {
+	switch n.(type) {
+	case *Schema:
+		return &Schema{}
+	case *Parameter:
+		return &Parameter{}
+	case *RequestBody:
+		return &RequestBody{}
+	case *Response:
+		return &Response{}
+	}
+	return nil
+}
+
+// assign copies the source node into the dest node of the same kind keeping the dest reference.
+func assign(dest, source interface{}) {
+	switch d := dest.(type) {
+	case *Schema:
+		ref := d.Ref
+		*d = *source.(*Schema)
+		d.Ref = ref
+	case *Parameter:
+		ref := d.Ref
+		*d = *source.(*Parameter)
+		d.Ref = ref
+		if d.ExternalName == "" {
+			d.ExternalName = d.Name
+		}
+	case *RequestBody:
+		ref := d.Ref
+		*d = *source.(*RequestBody)
+		d.Ref = ref
+	case *Response:
+		ref := d.Ref
+		*d = *source.(*Response)
+		d.Ref = ref
+	}
+}
+
+// find walks the raw document by the JSON Pointer tokens.
+// Mappings of the raw document are MapSlices, so copies of the found nodes keep the order of keys.
+func (d *document) find(tokens []string) (interface{}, error) {
+	if d.raw == nil {
+		raw := yaml.MapSlice{}
+		if err := yaml.Unmarshal(d.data, &raw); err != nil {
+			return nil, err
+		}
+		d.raw = raw
+	}
+
+	node := d.raw
+	for _, t := range tokens {
+		switch n := node.(type) {
+		case yaml.MapSlice:
+			found := false
+			for _, item := range n {
+				if fmt.Sprint(item.Key) == t {
+					node, found = item.Value, true
+					break
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("key %q not found", t)
+			}
+		case []interface{}:
+			i, err := strconv.Atoi(t)
+			if err != nil || i < 0 || i >= len(n) {
+				return nil, fmt.Errorf("index %q out of range", t)
+			}
+			node = n[i]
+		default:
+			return nil, fmt.Errorf("key %q not found", t)
+		}
+	}
+	return node, nil
+}
+
+// SplitRef splits reference into the document location and JSON Pointer,
+// e.g.: "./common.yaml#/components/schemas/Money".
+func SplitRef(ref string) (location, pointer string) {
+	i := strings.Index(ref, "#")
+	if i < 0 {
+		return ref, ""
+	}
+	return ref[:i], ref[i+1:]
+}
+
+// splitPointer splits JSON Pointer into unescaped tokens https://tools.ietf.org/html/rfc6901
+func splitPointer(pointer string) []string {
+	pointer = strings.TrimPrefix(pointer, "/")
+	if pointer == "" {
+		return []string{}
+	}
+
+	tokens := strings.Split(pointer, "/")
+	for i, t := range tokens {
+		tokens[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(t)
+	}
+	return tokens
+}
+
+// resolveLocation resolves location of the referenced document relative to the base document.
+func resolveLocation(base, location string) (string, error) {
+	if u, err := url.Parse(location); err == nil && u.IsAbs() {
+		return location, nil
+	}
+
+	if u, err := url.ParseRequestURI(base); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		r, err := url.Parse(location)
+		if err != nil {
+			return "", err
+		}
+		return u.ResolveReference(r).String(), nil
+	}
+
+	if filepath.IsAbs(location) {
+		return location, nil
+	}
+	return filepath.Join(filepath.Dir(base), location), nil
+}
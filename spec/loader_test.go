@@ -0,0 +1,191 @@
+package spec
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadExternalRefWithLocalName(t *testing.T) {
+	s, err := Load("../testdata/refs/api.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	order := s.Components.Schemas["Order"]
+	price, discount := order.Properties["price"], order.Properties["discount"]
+	assert.Contains(t, price.Properties, "amount")
+	assert.Contains(t, discount.Properties, "cents")
+
+	// Resolved references are located at the referenced schemas.
+	assert.Equal(t, "common.yaml", filepath.Base(s.Locate(price).File))
+	assert.Equal(t, "#/components/schemas/Money", s.Locate(price).Pointer)
+	assert.Equal(t, "api.yaml", filepath.Base(s.Locate(discount).File))
+	assert.Equal(t, "#/components/schemas/Money", s.Locate(discount).Pointer)
+
+	// References of the external document are resolved against it.
+	balance := order.Properties["customer"].Properties["balance"]
+	assert.Contains(t, balance.Properties, "currency")
+	assert.Equal(t, "common.yaml", filepath.Base(s.Locate(balance).File))
+}
+
+func TestLoadBackReference(t *testing.T) {
+	l := newLoader()
+	d, err := l.load("./../testdata/refs/back.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := l.result(d, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The root is loaded once though the reference back to it is resolved to the cleaned path.
+	assert.Len(t, l.documents, 2)
+	x := s.Components.Schemas["X"]
+	back := x.Properties["y"].Properties["x"]
+	assert.Equal(t, s.Locate(x), s.Locate(back))
+	assert.Equal(t, filepath.Join("..", "testdata", "refs", "back.yaml"), s.Locate(back).File)
+}
+
+func TestLoadUnresolvedRef(t *testing.T) {
+	_, err := Parse([]byte(`
+components:
+  schemas:
+    Order:
+      properties:
+        price:
+          $ref: "#/components/schemas/Money"
+`))
+	ds, ok := err.(Diagnostics)
+	if !ok || len(ds) != 1 {
+		t.Fatalf("expected one diagnostic, got %v", err)
+	}
+	assert.Equal(t, "#/components/schemas/Order/properties/price", ds[0].Location.Pointer)
+	assert.Contains(t, ds[0].Message, "unresolved reference #/components/schemas/Money")
+}
+
+func TestLoadRecursiveSchemas(t *testing.T) {
+	s, err := Load("../testdata/refs/tree.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// References are copies sharing the properties of the referenced schema, so recursion ends at the first copy.
+	node := s.Components.Schemas["TreeNode"]
+	deep := node.Properties["parent"].Properties["children"].Items.Properties["meta"].Properties["owner"]
+	assert.Equal(t, "string", deep.Properties["value"].Type)
+	assert.Equal(t, "#/components/schemas/TreeNode", s.Locate(deep).Pointer)
+	assert.Equal(t, "#/components/schemas/TreeNode", deep.Ref)
+
+	a := node.Properties["a"].Properties["b"].Properties["a"]
+	assert.Equal(t, "#/components/schemas/A", s.Locate(a).Pointer)
+	assert.Contains(t, a.Properties, "b")
+
+	assert.Equal(t, "string", s.Components.Schemas["Alias1"].Type)
+}
+
+func TestLoadCircularRef(t *testing.T) {
+	_, err := Parse([]byte(`
+components:
+  schemas:
+    A:
+      $ref: "#/components/schemas/B"
+    B:
+      $ref: "#/components/schemas/A"
+`))
+	ds, ok := err.(Diagnostics)
+	if !ok || len(ds) == 0 {
+		t.Fatalf("expected diagnostics, got %v", err)
+	}
+	assert.Contains(t, ds[0].Message, "circular reference")
+}
+
+func TestLoadRefKeepsPropertyOrder(t *testing.T) {
+	s, err := Load("../testdata/refs/order.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schemas := s.Components.Schemas
+	assert.Equal(t, []string{"zeta", "alpha", "mid"}, schemas["Pet"].PropertyNames())
+	assert.Equal(t, []string{"zulu", "bravo"}, schemas["Pet"].Properties["mid"].PropertyNames())
+	assert.Equal(t, []string{"zulu", "bravo"}, schemas["Mid"].PropertyNames())
+	assert.Equal(t, []string{"street", "city"}, schemas["Address"].PropertyNames())
+}
+
+func TestMergedProperties(t *testing.T) {
+	s, err := Load("../testdata/pets.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	properties, required := s.Components.Schemas["Dog"].MergedProperties()
+	assert.Equal(t, []string{"breed", "id", "name", "nested", "tag"}, SortedKeys(properties))
+	assert.Equal(t, "integer", properties["id"].Type)
+	assert.Equal(t, "string", properties["breed"].Type)
+	assert.ElementsMatch(t, []string{"id", "name", "breed"}, required)
+}
+
+func TestMergeParameters(t *testing.T) {
+	s, err := Parse([]byte(`
+paths:
+  /pets/{petId}:
+    parameters:
+      - name: petId
+        in: path
+        required: true
+        schema:
+          type: string
+      - name: trace
+        in: header
+        schema:
+          type: string
+      - $ref: "#/components/parameters/Limit"
+    head:
+      operationId: headPet
+      parameters:
+        - name: petId
+          in: path
+          required: true
+          schema:
+            type: integer
+        - name: trace
+          in: query
+          schema:
+            type: boolean
+      responses:
+        '200':
+          description: pet exists
+    options:
+      operationId: petOptions
+      responses:
+        '204':
+          description: allowed methods
+components:
+  parameters:
+    Limit:
+      name: limit
+      in: query
+      schema:
+        type: integer
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item := s.Paths["/pets/{petId}"]
+	assert.Equal(t, []string{"HEAD", "OPTIONS"}, SortedKeys(item.GetMethodsMap()))
+
+	// The operation parameter overrides the path level one with the same name and location only.
+	params := func(o *Operation) []string {
+		ps := []string{}
+		for _, p := range o.Parameters {
+			ps = append(ps, p.In+" "+p.ExternalName+" "+p.Schema.Type)
+		}
+		return ps
+	}
+	assert.Equal(t, []string{"header trace string", "query limit integer", "path petId integer", "query trace boolean"}, params(item.HEAD))
+	assert.Equal(t, []string{"path petId string", "header trace string", "query limit integer"}, params(item.OPTIONS))
+}
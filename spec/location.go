@@ -0,0 +1,164 @@
+package spec
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	yaml3 "gopkg.in/yaml.v3"
+)
+
+// Location of the node in the specification documents.
+type Location struct {
+	File string
+	// Pointer is JSON Pointer of the node in the file e.g.: "#/paths/~1pets/get/parameters/0/schema"
+	Pointer string
+	// Line and Column are 1-based, zero if the position is unknown.
+	Line   int
+	Column int
+}
+
+func (l Location) String() string {
+	s := l.File
+	if l.Line > 0 {
+		s += fmt.Sprintf(":%d:%d", l.Line, l.Column)
+	}
+	if l.Pointer != "" {
+		if s != "" {
+			s += " "
+		}
+		s += l.Pointer
+	}
+	return s
+}
+
+// Diagnostic is a problem of the specification at the location.
+type Diagnostic struct {
+	Location Location
+	Message  string
+}
+
+func (d Diagnostic) Error() string {
+	if l := d.Location.String(); l != "" {
+		return l + ": " + d.Message
+	}
+	return d.Message
+}
+
+// Diagnostics are all problems found in the specification. Diagnostics are returned as an error.
+type Diagnostics []Diagnostic
+
+func (ds Diagnostics) Error() string {
+	lines := make([]string, len(ds))
+	for i, d := range ds {
+		lines[i] = d.Error()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Sort sorts the diagnostics by files and positions.
+func (ds Diagnostics) Sort() {
+	sort.SliceStable(ds, func(i, j int) bool {
+		a, b := ds[i].Location, ds[j].Location
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+}
+
+// Compact returns the sorted diagnostics without duplicates e.g.: errors of the document referenced several times.
+func (ds Diagnostics) Compact() Diagnostics {
+	ds.Sort()
+	out := Diagnostics{}
+	for i, d := range ds {
+		if i > 0 && d == ds[i-1] {
+			continue
+		}
+		out = append(out, d)
+	}
+	return out
+}
+
+// Locate returns the location of the node of the specification.
+// Nodes copied by resolving references are located at the referenced nodes.
+func (s *Swagger) Locate(node interface{}) Location {
+	if !isNode(node) {
+		return Location{}
+	}
+	return s.locations[node]
+}
+
+// locate records locations of the root and all its nodes unless they are already located.
+func (l *loader) locate(d *document, root interface{}, tokens []string) {
+	walk(root, tokens, func(n interface{}, tokens []string) bool {
+		if !isNode(n) {
+			return true
+		}
+		if _, ok := l.locations[n]; ok {
+			return true
+		}
+		loc := Location{File: d.location, Pointer: "#" + pointerOf(tokens)}
+		loc.Line, loc.Column = d.position(tokens)
+		l.locations[n] = loc
+		return true
+	})
+}
+
+// isNode reports whether the n is a node which can be located by its address.
+func isNode(n interface{}) bool {
+	switch n.(type) {
+	case *Schema, *Parameter, *RequestBody, *Response, *Operation, *MediaType:
+		return true
+	}
+	return false
+}
+
+// position returns the line and the column of the node by the JSON Pointer tokens or zeros if it is not found.
+func (d *document) position(tokens []string) (int, int) {
+	if d.tree == nil {
+		d.tree = &yaml3.Node{}
+		if err := yaml3.Unmarshal(d.data, d.tree); err != nil {
+			return 0, 0
+		}
+	}
+	if len(d.tree.Content) == 0 {
+		return 0, 0
+	}
+
+	node := d.tree.Content[0]
+	for _, t := range tokens {
+		next := (*yaml3.Node)(nil)
+		switch node.Kind {
+		case yaml3.MappingNode:
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				if node.Content[i].Value == t {
+					next = node.Content[i+1]
+					break
+				}
+			}
+		case yaml3.SequenceNode:
+			if i, err := strconv.Atoi(t); err == nil && i >= 0 && i < len(node.Content) {
+				next = node.Content[i]
+			}
+		}
+		if next == nil {
+			return 0, 0
+		}
+		node = next
+	}
+	return node.Line, node.Column
+}
+
+// pointerOf builds JSON Pointer by unescaped tokens.
+func pointerOf(tokens []string) string {
+	escaped := make([]string, len(tokens))
+	for i, t := range tokens {
+		escaped[i] = strings.NewReplacer("~", "~0", "/", "~1").Replace(t)
+	}
+	return "/" + strings.Join(escaped, "/")
+}
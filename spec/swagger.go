@@ -0,0 +1,567 @@
+// Package spec is a model of OpenAPI specification documents and their loader.
+package spec
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+	"net/url"
+)
+
+// Swagger https://swagger.io/specification/
+type Swagger struct {
+	Info       Info
+	OpenAPI    string
+	Servers    []Server
+	Paths      map[string]PathItem
+	Components Components
+
+	// locations of the nodes set by the loader.
+	locations map[interface{}]Location
+	// pathOrder is the declaration order of the Paths.
+	pathOrder []string
+	// file is the location of the root document set by the loader.
+	file string
+}
+
+// Info https://swagger.io/specification/#infoObject
+type Info struct {
+	Title   string
+	Version string
+}
+
+// PathItem https://swagger.io/specification/#pathItemObject
+type PathItem struct {
+	Summary     string
+	Description string
+	GET         *Operation
+	POST        *Operation
+	PATCH       *Operation
+	PUT         *Operation
+	DELETE      *Operation
+	HEAD        *Operation
+	OPTIONS     *Operation
+	TRACE       *Operation
+	Servers     []Server
+	Parameters  []*Parameter
+
+	// keyOrder is the declaration order of the keys of the PathItem.
+	keyOrder []string
+}
+
+// Operation https://swagger.io/specification/#operationObject
+type Operation struct {
+	OperationID string `yaml:"operationId"`
+	Summary     string
+	Description string
+	Tags        []string
+	RequestBody *RequestBody `yaml:"requestBody"`
+	Parameters  []*Parameter
+	Responses   map[string]*Response
+}
+
+// RequestBody https://github.com/OAI/OpenAPI-Specification/blob/OpenAPI.next/versions/3.0.0.md#requestBodyObject
+type RequestBody struct {
+	Description string
+	Required    bool
+	Content     map[string]*MediaType
+	Ref         string `yaml:"$ref"`
+}
+
+// Parameter https://swagger.io/specification/#parameterObject
+type Parameter struct {
+	Name         string
+	ExternalName string
+	In           string
+	Required     bool
+	Schema       *Schema
+	Ref          string `yaml:"$ref"`
+}
+
+// Response https://swagger.io/specification/#responseObject
+type Response struct {
+	Description string
+	Headers     map[string]*Header
+	Content     map[string]*MediaType
+	Links       map[string]*Link
+	Ref         string `yaml:"$ref"`
+}
+
+// Server https://swagger.io/specification/#serverObject
+type Server struct {
+	URL string
+}
+
+// Components https://swagger.io/specification/#componentsObject
+type Components struct {
+	Schemas       map[string]*Schema
+	Parameters    map[string]*Parameter
+	RequestBodies map[string]*RequestBody `yaml:"requestBodies"`
+	Responses     map[string]*Response    `yaml:"responses"`
+}
+
+// Schema https://swagger.io/specification/#schemaObject
+type Schema struct {
+	Name                 string
+	Ref                  string `yaml:"$ref"`
+	Type                 string
+	Format               string
+	Required             []string
+	Properties           map[string]*Schema
+	Items                *Schema
+	AllOf                []*Schema `yaml:"allOf"`
+	OneOf                []*Schema `yaml:"oneOf"`
+	AnyOf                []*Schema `yaml:"anyOf"`
+	Discriminator        *Discriminator
+	Parent               *Schema
+	AdditionalProperties *Schema             `yaml:"additionalProperties"`
+	Enum                 []string            `yaml:"enum"`
+	Default              string              `yaml:"default"`
+	ExtensionTags        map[string][]string `yaml:"x-oasgo-tags"`
+	// GoType is an existing Go type used instead of the generated one e.g.: uuid.UUID
+	GoType string `yaml:"x-go-type"`
+	// GoTypeImport is an import path of the GoType e.g.: github.com/google/uuid
+	GoTypeImport string `yaml:"x-go-type-import"`
+	Nullable     bool   `yaml:"nullable"`
+	Validation   `yaml:",inline"`
+
+	// propertyOrder is the declaration order of the Properties.
+	propertyOrder []string
+}
+
+// Validation keywords of the Schema https://swagger.io/specification/#properties
+type Validation struct {
+	Minimum          *float64 `yaml:"minimum"`
+	Maximum          *float64 `yaml:"maximum"`
+	ExclusiveMinimum bool     `yaml:"exclusiveMinimum"`
+	ExclusiveMaximum bool     `yaml:"exclusiveMaximum"`
+	MultipleOf       *float64 `yaml:"multipleOf"`
+	MinLength        *int     `yaml:"minLength"`
+	MaxLength        *int     `yaml:"maxLength"`
+	Pattern          string   `yaml:"pattern"`
+	MinItems         *int     `yaml:"minItems"`
+	MaxItems         *int     `yaml:"maxItems"`
+	UniqueItems      bool     `yaml:"uniqueItems"`
+	MinProperties    *int     `yaml:"minProperties"`
+	MaxProperties    *int     `yaml:"maxProperties"`
+}
+
+// Discriminator https://swagger.io/specification/#discriminatorObject
+type Discriminator struct {
+	PropertyName string `yaml:"propertyName"`
+	Mapping      map[string]string
+}
+
+// Header https://swagger.io/specification/#headerObject
+type Header struct {
+	Name        string
+	Description string
+}
+
+// MediaType https://swagger.io/specification/#mediaTypeObject
+type MediaType struct {
+	Schema *Schema
+}
+
+// Link https://swagger.io/specification/#linkObject
+type Link struct {
+	operationRef string
+	operationID  string `yaml:"operationId"`
+	description  string
+}
+
+// newSwagger decodes a single document. References are resolved by the loader.
+func newSwagger(data []byte) (*Swagger, error) {
+	swagger := Swagger{}
+	if err := yaml.Unmarshal(data, &swagger); err != nil {
+		return nil, err
+	}
+	order := struct{ Paths yaml.MapSlice }{}
+	if err := yaml.Unmarshal(data, &order); err != nil {
+		return nil, err
+	}
+	swagger.pathOrder = keysOf(order.Paths)
+	return &swagger, nil
+}
+
+// PathNames returns paths in the declaration order.
+func (s Swagger) PathNames() []string {
+	return orderedKeys(s.pathOrder, s.Paths)
+}
+
+// UnmarshalYAML records the declaration order of the PathItem keys.
+func (p *PathItem) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type rp PathItem
+
+	r := rp{}
+	if err := unmarshal(&r); err != nil {
+		return err
+	}
+	order := yaml.MapSlice{}
+	if err := unmarshal(&order); err != nil {
+		return err
+	}
+	r.keyOrder = keysOf(order)
+
+	*p = PathItem(r)
+	return nil
+}
+
+// MethodNames returns methods of the operations of the PathItem e.g.: GET in the declaration order.
+func (p PathItem) MethodNames() []string {
+	order := make([]string, len(p.keyOrder))
+	for i, k := range p.keyOrder {
+		order[i] = strings.ToUpper(k)
+	}
+	return orderedKeys(order, p.GetMethodsMap())
+}
+
+// GetMethodsMap converts PathItem fields to map but without nil Operations.
+func (p PathItem) GetMethodsMap() map[string]*Operation {
+	m := make(map[string]*Operation)
+	if p.GET != nil {
+		m["GET"] = p.GET
+	}
+	if p.POST != nil {
+		m["POST"] = p.POST
+	}
+	if p.PUT != nil {
+		m["PUT"] = p.PUT
+	}
+	if p.PATCH != nil {
+		m["PATCH"] = p.PATCH
+	}
+	if p.DELETE != nil {
+		m["DELETE"] = p.DELETE
+	}
+	if p.HEAD != nil {
+		m["HEAD"] = p.HEAD
+	}
+	if p.OPTIONS != nil {
+		m["OPTIONS"] = p.OPTIONS
+	}
+	if p.TRACE != nil {
+		m["TRACE"] = p.TRACE
+	}
+	return m
+}
+
+// mergeParameters adds path level parameters to every operation of the PathItem.
+// Operation parameters override path level ones with the same name and location.
+func (p PathItem) mergeParameters() {
+	for _, o := range p.GetMethodsMap() {
+		ps := []*Parameter{}
+		for _, pp := range p.Parameters {
+			overridden := false
+			for _, op := range o.Parameters {
+				if op.ExternalName == pp.ExternalName && op.In == pp.In {
+					overridden = true
+				}
+			}
+			if !overridden {
+				ps = append(ps, pp)
+			}
+		}
+		o.Parameters = append(ps, o.Parameters...)
+	}
+}
+
+// GetResult returns Schema with good status code.
+func (o *Operation) GetResult() (r *Schema) {
+	for _, c := range SortedKeys(o.Responses) {
+		r := o.Responses[c]
+		code, err := strconv.Atoi(c)
+		if err != nil {
+			continue
+		}
+		if code >= http.StatusOK && code < http.StatusBadRequest {
+			return r.Content["application/json"].Schema
+		}
+	}
+	return
+}
+
+// MergedProperties returns properties of the Schema together with properties of all allOf members.
+// Required lists are combined across members.
+func (s *Schema) MergedProperties() (map[string]*Schema, []string) {
+	properties := make(map[string]*Schema)
+	required := []string{}
+
+	for _, m := range s.AllOf {
+		ps, rs := m.MergedProperties()
+		for k, v := range ps {
+			properties[k] = v
+		}
+		required = append(required, rs...)
+	}
+	for k, v := range s.Properties {
+		properties[k] = v
+	}
+	required = append(required, s.Required...)
+
+	return properties, required
+}
+
+// PropertyNames returns names of the merged properties in the declaration order, properties of allOf members go first.
+func (s *Schema) PropertyNames() []string {
+	order := []string{}
+	for _, m := range s.AllOf {
+		order = append(order, m.PropertyNames()...)
+	}
+	order = append(order, s.propertyOrder...)
+
+	properties, _ := s.MergedProperties()
+	return orderedKeys(order, properties)
+}
+
+// UnmarshalYAML defines default Type for Schema struct.
+func (s *Schema) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type rs Schema
+
+	r := rs{Type: "object"}
+
+	if err := unmarshal(&r); err != nil {
+		return err
+	}
+	order := struct{ Properties yaml.MapSlice }{}
+	if err := unmarshal(&order); err != nil {
+		return err
+	}
+	r.propertyOrder = keysOf(order.Properties)
+
+	*s = Schema(r)
+
+	return nil
+}
+
+// UnmarshalYAML fills Name for Schemas struct from map keys.
+func (c *Components) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type rc Components
+
+	r := rc{}
+
+	if err := unmarshal(&r); err != nil {
+		return err
+	}
+
+	for k, v := range r.Schemas {
+		v.Name = k
+	}
+	for k, v := range r.Parameters {
+		v.ExternalName = v.Name
+		v.Name = k
+	}
+
+	*c = Components(r)
+
+	return nil
+}
+
+func (rb *RequestBody) Check(key string) bool {
+	return check([]string{"application/json"}, key)
+}
+
+func (rb *Response) Check(key string) bool {
+	return check([]string{"application/json"}, key)
+}
+
+// Inspect calls visitor function on (almost) every node within Swagger struct.
+func Inspect(node interface{}, visitor func(i interface{}) bool) {
+	walk(node, nil, func(n interface{}, tokens []string) bool {
+		return visitor(n)
+	})
+}
+
+// walk calls visitor function on every node that Inspect visits with unescaped JSON Pointer tokens of the node.
+func walk(node interface{}, tokens []string, visitor func(n interface{}, tokens []string) bool) {
+	if ok := visitor(node, tokens); !ok {
+		return
+	}
+
+	switch n := node.(type) {
+	case Swagger:
+		walk(n.Components, appendTokens(tokens, "components"), visitor)
+
+		for _, k := range SortedKeys(n.Paths) {
+			walk(n.Paths[k], appendTokens(tokens, "paths", k), visitor)
+		}
+	case PathItem:
+		methods := n.GetMethodsMap()
+		for _, m := range SortedKeys(methods) {
+			walk(methods[m], appendTokens(tokens, strings.ToLower(m)), visitor)
+		}
+		for i, v := range n.Parameters {
+			walk(v, appendTokens(tokens, "parameters", strconv.Itoa(i)), visitor)
+		}
+	case *Operation:
+		for i, v := range n.Parameters {
+			walk(v, appendTokens(tokens, "parameters", strconv.Itoa(i)), visitor)
+		}
+		for _, k := range SortedKeys(n.Responses) {
+			walk(n.Responses[k], appendTokens(tokens, "responses", k), visitor)
+		}
+		if n.RequestBody != nil {
+			walk(n.RequestBody, appendTokens(tokens, "requestBody"), visitor)
+		}
+	case *Parameter:
+		if n.Schema != nil {
+			walk(n.Schema, appendTokens(tokens, "schema"), visitor)
+		}
+	case *Response:
+		for _, k := range SortedKeys(n.Content) {
+			walk(n.Content[k], appendTokens(tokens, "content", k), visitor)
+		}
+	case *MediaType:
+		if n.Schema != nil {
+			walk(n.Schema, appendTokens(tokens, "schema"), visitor)
+		}
+	case Components:
+		for _, k := range SortedKeys(n.Schemas) {
+			walk(n.Schemas[k], appendTokens(tokens, "schemas", k), visitor)
+		}
+		for _, k := range SortedKeys(n.Parameters) {
+			walk(n.Parameters[k], appendTokens(tokens, "parameters", k), visitor)
+		}
+		for _, k := range SortedKeys(n.RequestBodies) {
+			walk(n.RequestBodies[k], appendTokens(tokens, "requestBodies", k), visitor)
+		}
+		for _, k := range SortedKeys(n.Responses) {
+			walk(n.Responses[k], appendTokens(tokens, "responses", k), visitor)
+		}
+	case *Schema:
+		if n.Items != nil {
+			n.Items.Parent = n
+			walk(n.Items, appendTokens(tokens, "items"), visitor)
+		}
+		for _, k := range SortedKeys(n.Properties) {
+			v := n.Properties[k]
+			v.Parent = n
+			walk(v, appendTokens(tokens, "properties", k), visitor)
+		}
+		for i, v := range n.AllOf {
+			v.Parent = n
+			walk(v, appendTokens(tokens, "allOf", strconv.Itoa(i)), visitor)
+		}
+		for i, v := range n.OneOf {
+			v.Parent = n
+			walk(v, appendTokens(tokens, "oneOf", strconv.Itoa(i)), visitor)
+		}
+		for i, v := range n.AnyOf {
+			v.Parent = n
+			walk(v, appendTokens(tokens, "anyOf", strconv.Itoa(i)), visitor)
+		}
+		if n.AdditionalProperties != nil {
+			n.AdditionalProperties.Parent = n
+			walk(n.AdditionalProperties, appendTokens(tokens, "additionalProperties"), visitor)
+		}
+	case *RequestBody:
+		for _, k := range SortedKeys(n.Content) {
+			walk(n.Content[k], appendTokens(tokens, "content", k), visitor)
+		}
+	}
+}
+
+// SortedKeys returns keys of the map in ascending order to iterate the map deterministically.
+func SortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// orderedKeys returns keys of the map in the order, keys missing in the order follow them sorted.
+func orderedKeys[V any](order []string, m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	seen := map[string]bool{}
+	for _, k := range order {
+		if _, ok := m[k]; ok && !seen[k] {
+			keys = append(keys, k)
+			seen[k] = true
+		}
+	}
+	for _, k := range SortedKeys(m) {
+		if !seen[k] {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+// keysOf returns keys of the mapping as strings in the declaration order e.g.: "200" of the key 200.
+func keysOf(ms yaml.MapSlice) []string {
+	keys := make([]string, len(ms))
+	for i, item := range ms {
+		keys[i] = fmt.Sprint(item.Key)
+	}
+	return keys
+}
+
+// appendTokens returns a new slice of the tokens followed by the next ones.
+func appendTokens(tokens []string, next ...string) []string {
+	return append(append(make([]string, 0, len(tokens)+len(next)), tokens...), next...)
+}
+
+// Load reads the specification by the path or URL and resolves all its references including external ones.
+// Problems of the specification are returned as Diagnostics.
+func Load(path string) (*Swagger, error) {
+	l := newLoader()
+	return l.result(l.load(path))
+}
+
+// Parse decodes the specification document and resolves its references.
+// Relative external references are resolved against the working directory.
+func Parse(data []byte) (*Swagger, error) {
+	l := newLoader()
+	return l.result(l.add("", data))
+}
+
+// result returns the loaded specification or all problems found by the loader.
+func (l *loader) result(d *document, err error) (*Swagger, error) {
+	if err != nil {
+		return nil, err
+	}
+	if len(l.diagnostics) > 0 {
+		return nil, l.diagnostics.Compact()
+	}
+	d.swagger.locations = l.locations
+	d.swagger.file = d.location
+	return d.swagger, nil
+}
+
+// File returns the location of the root document of the specification.
+func (s *Swagger) File() string {
+	return s.file
+}
+
+func check(availableKeys []string, key string) bool {
+	for _, el := range availableKeys {
+		if key == el {
+			return true
+		}
+	}
+	return false
+}
+
+// readFile Read the file by URL, if the path is a reference, else from the local file
+func readFile(path string) ([]byte, error) {
+	url, err := url.ParseRequestURI(path)
+	if err == nil {
+		switch url.Scheme {
+		case "http", "https":
+			r, err := http.Get(path)
+			if err != nil {
+				return nil, err
+			}
+			defer r.Body.Close()
+			return ioutil.ReadAll(r.Body)
+		}
+	}
+	return ioutil.ReadFile(path)
+}